@@ -415,7 +415,8 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"aws_acm_certificate": acm.DataSourceCertificate(),
+			"aws_acm_certificate":        acm.DataSourceCertificate(),
+			"aws_acm_certificate_export": acm.DataSourceCertificateExport(),
 
 			"aws_acmpca_certificate_authority": acmpca.DataSourceCertificateAuthority(),
 			"aws_acmpca_certificate":           acmpca.DataSourceCertificate(),
@@ -461,6 +462,7 @@ func Provider() *schema.Provider {
 			"aws_cloudfront_cache_policy":                   cloudfront.DataSourceCachePolicy(),
 			"aws_cloudfront_distribution":                   cloudfront.DataSourceDistribution(),
 			"aws_cloudfront_function":                       cloudfront.DataSourceFunction(),
+			"aws_cloudfront_function_test":                  cloudfront.DataSourceFunctionTest(),
 			"aws_cloudfront_log_delivery_canonical_user_id": cloudfront.DataSourceLogDeliveryCanonicalUserID(),
 			"aws_cloudfront_origin_access_identities":       cloudfront.DataSourceOriginAccessIdentities(),
 			"aws_cloudfront_origin_access_identity":         cloudfront.DataSourceOriginAccessIdentity(),
@@ -698,8 +700,9 @@ func Provider() *schema.Provider {
 			"aws_mskconnect_custom_plugin":        kafkaconnect.DataSourceCustomPlugin(),
 			"aws_mskconnect_worker_configuration": kafkaconnect.DataSourceWorkerConfiguration(),
 
-			"aws_kinesis_stream":          kinesis.DataSourceStream(),
-			"aws_kinesis_stream_consumer": kinesis.DataSourceStreamConsumer(),
+			"aws_kinesis_stream":           kinesis.DataSourceStream(),
+			"aws_kinesis_stream_consumer":  kinesis.DataSourceStreamConsumer(),
+			"aws_kinesis_stream_consumers": kinesis.DataSourceStreamConsumers(),
 
 			"aws_kms_alias":      kms.DataSourceAlias(),
 			"aws_kms_ciphertext": kms.DataSourceCiphertext(),
@@ -836,6 +839,7 @@ func Provider() *schema.Provider {
 			"aws_servicequotas_service_quota": servicequotas.DataSourceServiceQuota(),
 
 			"aws_sfn_activity":      sfn.DataSourceActivity(),
+			"aws_sfn_executions":    sfn.DataSourceExecutions(),
 			"aws_sfn_state_machine": sfn.DataSourceStateMachine(),
 
 			"aws_signer_signing_job":     signer.DataSourceSigningJob(),
@@ -927,6 +931,7 @@ func Provider() *schema.Provider {
 			"aws_api_gateway_stage":                 apigateway.ResourceStage(),
 			"aws_api_gateway_usage_plan":            apigateway.ResourceUsagePlan(),
 			"aws_api_gateway_usage_plan_key":        apigateway.ResourceUsagePlanKey(),
+			"aws_api_gateway_usage_plan_keys":       apigateway.ResourceUsagePlanKeys(),
 			"aws_api_gateway_vpc_link":              apigateway.ResourceVPCLink(),
 
 			"aws_apigatewayv2_api":                  apigatewayv2.ResourceAPI(),
@@ -1250,6 +1255,7 @@ func Provider() *schema.Provider {
 			"aws_ebs_volume":                                       ec2.ResourceEBSVolume(),
 			"aws_ec2_availability_zone_group":                      ec2.ResourceAvailabilityZoneGroup(),
 			"aws_ec2_capacity_reservation":                         ec2.ResourceCapacityReservation(),
+			"aws_ec2_capacity_reservation_fleet":                   ec2.ResourceCapacityReservationFleet(),
 			"aws_ec2_carrier_gateway":                              ec2.ResourceCarrierGateway(),
 			"aws_ec2_client_vpn_authorization_rule":                ec2.ResourceClientVPNAuthorizationRule(),
 			"aws_ec2_client_vpn_endpoint":                          ec2.ResourceClientVPNEndpoint(),
@@ -1665,6 +1671,8 @@ func Provider() *schema.Provider {
 			"aws_opensearch_domain":              opensearch.ResourceDomain(),
 			"aws_opensearch_domain_policy":       opensearch.ResourceDomainPolicy(),
 			"aws_opensearch_domain_saml_options": opensearch.ResourceDomainSAMLOptions(),
+			"aws_opensearch_package":             opensearch.ResourcePackage(),
+			"aws_opensearch_package_association": opensearch.ResourcePackageAssociation(),
 
 			"aws_opsworks_application":       opsworks.ResourceApplication(),
 			"aws_opsworks_custom_layer":      opsworks.ResourceCustomLayer(),
@@ -141,6 +141,30 @@ func ResourceCertificate() *schema.Resource {
 				Sensitive:    true,
 				ExactlyOneOf: []string{"domain_name", "private_key"},
 			},
+			"renewal_eligibility": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"renewal_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"renewal_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"renewal_status_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -369,6 +393,14 @@ func resourceCertificateRead(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		d.Set("options", nil)
 	}
+	d.Set("renewal_eligibility", certificate.RenewalEligibility)
+	if certificate.RenewalSummary != nil {
+		if err := d.Set("renewal_summary", []interface{}{flattenRenewalSummary(certificate.RenewalSummary)}); err != nil {
+			return fmt.Errorf("error setting renewal_summary: %w", err)
+		}
+	} else {
+		d.Set("renewal_summary", nil)
+	}
 	d.Set("status", certificate.Status)
 	d.Set("subject_alternative_names", aws.StringValueSlice(certificate.SubjectAlternativeNames))
 	d.Set("validation_emails", validationEmails)
@@ -611,6 +643,28 @@ func flattenDomainValidations(apiObjects []*acm.DomainValidation) ([]interface{}
 	return tfList, tfStrings
 }
 
+func flattenRenewalSummary(apiObject *acm.RenewalSummary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.RenewalStatus; v != nil {
+		tfMap["renewal_status"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RenewalStatusReason; v != nil {
+		tfMap["renewal_status_reason"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.UpdatedAt; v != nil {
+		tfMap["updated_at"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return tfMap
+}
+
 func isChangeNormalizeCertRemoval(oldRaw, newRaw interface{}) bool {
 	old, ok := oldRaw.(string)
 
@@ -9,18 +9,24 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/route53"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+const (
+	certificateValidationRecordTTL = 60
+)
+
 func ResourceCertificateValidation() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCertificateValidationCreate,
 		Read:   resourceCertificateValidationRead,
-		Delete: schema.Noop,
+		Delete: resourceCertificateValidationDelete,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(75 * time.Minute),
@@ -32,11 +38,18 @@ func ResourceCertificateValidation() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"route53_zone_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"validation_record_fqdns"},
+			},
 			"validation_record_fqdns": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				ForceNew: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"route53_zone_id"},
 			},
 		},
 	}
@@ -56,6 +69,27 @@ func resourceCertificateValidationCreate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("ACM Certificate (%s) has type %s, no validation necessary", arn, v)
 	}
 
+	if v, ok := d.GetOk("route53_zone_id"); ok {
+		zoneID := v.(string)
+
+		// The DNS validation records may not have been assigned yet if the certificate was only just requested.
+		certificate, err = waitCertificateDomainValidationsAvailable(conn, arn, AcmCertificateDnsValidationAssignmentTimeout)
+
+		if err != nil {
+			return fmt.Errorf("waiting for ACM Certificate (%s) DNS validation records: %w", arn, err)
+		}
+
+		changes, err := certificateValidationRecordChanges(certificate, route53.ChangeActionUpsert)
+
+		if err != nil {
+			return err
+		}
+
+		if err := changeCertificateValidationRecords(meta.(*conns.AWSClient).Route53Conn, zoneID, changes); err != nil {
+			return fmt.Errorf("creating ACM Certificate (%s) DNS validation records in Route 53 Hosted Zone (%s): %w", arn, zoneID, err)
+		}
+	}
+
 	if v, ok := d.GetOk("validation_record_fqdns"); ok && v.(*schema.Set).Len() > 0 {
 		fqdns := make(map[string]*acm.DomainValidation)
 
@@ -116,6 +150,116 @@ func resourceCertificateValidationRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceCertificateValidationDelete(d *schema.ResourceData, meta interface{}) error {
+	v, ok := d.GetOk("route53_zone_id")
+
+	if !ok {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).ACMConn
+	zoneID := v.(string)
+	arn := d.Get("certificate_arn").(string)
+
+	certificate, err := FindCertificateByARN(conn, arn)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading ACM Certificate (%s): %w", arn, err)
+	}
+
+	changes, err := certificateValidationRecordChanges(certificate, route53.ChangeActionDelete)
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting ACM Certificate (%s) DNS validation records in Route 53 Hosted Zone (%s)", arn, zoneID)
+	if err := changeCertificateValidationRecords(meta.(*conns.AWSClient).Route53Conn, zoneID, changes); err != nil {
+		return fmt.Errorf("deleting ACM Certificate (%s) DNS validation records in Route 53 Hosted Zone (%s): %w", arn, zoneID, err)
+	}
+
+	return nil
+}
+
+// certificateValidationRecordChanges returns the Route 53 changes for the certificate's DNS validation records.
+// Records shared by more than one domain, e.g. a domain and its wildcard, are only included once.
+func certificateValidationRecordChanges(certificate *acm.CertificateDetail, action string) ([]*route53.Change, error) {
+	var changes []*route53.Change
+	seen := make(map[string]bool)
+
+	for _, domainValidation := range certificate.DomainValidationOptions {
+		if v := aws.StringValue(domainValidation.ValidationMethod); v != acm.ValidationMethodDns {
+			return nil, fmt.Errorf("route53_zone_id is not valid for %s validation", v)
+		}
+
+		record := domainValidation.ResourceRecord
+
+		if record == nil {
+			continue
+		}
+
+		name := aws.StringValue(record.Name)
+
+		if seen[name] {
+			continue
+		}
+
+		seen[name] = true
+
+		changes = append(changes, &route53.Change{
+			Action: aws.String(action),
+			ResourceRecordSet: &route53.ResourceRecordSet{
+				Name: record.Name,
+				ResourceRecords: []*route53.ResourceRecord{{
+					Value: record.Value,
+				}},
+				TTL:  aws.Int64(certificateValidationRecordTTL),
+				Type: record.Type,
+			},
+		})
+	}
+
+	return changes, nil
+}
+
+func changeCertificateValidationRecords(conn *route53.Route53, zoneID string, changes []*route53.Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	input := &route53.ChangeResourceRecordSetsInput{
+		ChangeBatch: &route53.ChangeBatch{
+			Comment: aws.String("Managed by Terraform"),
+			Changes: changes,
+		},
+		HostedZoneId: aws.String(tfroute53.CleanZoneID(zoneID)),
+	}
+
+	var outputRaw interface{}
+	var err error
+
+	if aws.StringValue(changes[0].Action) == route53.ChangeActionDelete {
+		// Records that have already been removed are not an error.
+		outputRaw, err = tfroute53.DeleteRecordSet(conn, input)
+	} else {
+		outputRaw, err = conn.ChangeResourceRecordSets(input)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if output, ok := outputRaw.(*route53.ChangeResourceRecordSetsOutput); ok && output != nil && output.ChangeInfo != nil {
+		return tfroute53.WaitForRecordSetToSync(conn, tfroute53.CleanChangeID(aws.StringValue(output.ChangeInfo.Id)))
+	}
+
+	return nil
+}
+
 func FindCertificateValidationByARN(conn *acm.ACM, arn string) (*acm.CertificateDetail, error) {
 	output, err := FindCertificateByARN(conn, arn)
 
@@ -38,6 +38,31 @@ func TestAccACMCertificateValidation_basic(t *testing.T) {
 	})
 }
 
+func TestAccACMCertificateValidation_route53ZoneID(t *testing.T) {
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	wildcardDomain := fmt.Sprintf("*.%s", domain)
+	certificateResourceName := "aws_acm_certificate.test"
+	resourceName := "aws_acm_certificate_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, acm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAcmCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAcmCertificateValidationRoute53ZoneIDConfig(rootDomain, domain, strconv.Quote(wildcardDomain)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAcmCertificateValidationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_arn", certificateResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "route53_zone_id", "data.aws_route53_zone.test", "zone_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccACMCertificateValidation_timeout(t *testing.T) {
 	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
 	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
@@ -292,6 +317,26 @@ resource "aws_acm_certificate_validation" "test" {
 `, domainName, rootZoneDomain)
 }
 
+func testAccAcmCertificateValidationRoute53ZoneIDConfig(rootZoneDomain, domainName, subjectAlternativeNames string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  domain_name               = %[1]q
+  subject_alternative_names = [%[2]s]
+  validation_method         = "DNS"
+}
+
+data "aws_route53_zone" "test" {
+  name         = %[3]q
+  private_zone = false
+}
+
+resource "aws_acm_certificate_validation" "test" {
+  certificate_arn = aws_acm_certificate.test.arn
+  route53_zone_id = data.aws_route53_zone.test.zone_id
+}
+`, domainName, subjectAlternativeNames, rootZoneDomain)
+}
+
 func testAccAcmCertificateValidationTimeoutConfig(domainName string) string {
 	return fmt.Sprintf(`
 resource "aws_acm_certificate" "test" {
@@ -32,3 +32,37 @@ func FindStageByName(conn *apigateway.APIGateway, restApiId, name string) (*apig
 
 	return output, nil
 }
+
+func FindUsagePlanKeysByUsagePlanID(conn *apigateway.APIGateway, usagePlanID string) ([]*apigateway.UsagePlanKey, error) {
+	input := &apigateway.GetUsagePlanKeysInput{
+		UsagePlanId: aws.String(usagePlanID),
+	}
+	var output []*apigateway.UsagePlanKey
+
+	err := conn.GetUsagePlanKeysPages(input, func(page *apigateway.GetUsagePlanKeysOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Items {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -580,6 +580,21 @@ func resourceRestAPIUpdateOperations(d *schema.ResourceData) []*apigateway.Patch
 	return operations
 }
 
+// splitRestAPIPolicyUpdateOperations separates the policy patch operation from the other operations.
+func splitRestAPIPolicyUpdateOperations(operations []*apigateway.PatchOperation) ([]*apigateway.PatchOperation, []*apigateway.PatchOperation) {
+	var policyOperations, otherOperations []*apigateway.PatchOperation
+
+	for _, operation := range operations {
+		if aws.StringValue(operation.Path) == "/policy" {
+			policyOperations = append(policyOperations, operation)
+		} else {
+			otherOperations = append(otherOperations, operation)
+		}
+	}
+
+	return policyOperations, otherOperations
+}
+
 func resourceRestAPIUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).APIGatewayConn
 	log.Printf("[DEBUG] Updating API Gateway %s", d.Id())
@@ -724,13 +739,35 @@ func resourceRestAPIUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	_, err := conn.UpdateRestApi(&apigateway.UpdateRestApiInput{
-		RestApiId:       aws.String(d.Id()),
-		PatchOperations: resourceRestAPIUpdateOperations(d),
-	})
+	operations := resourceRestAPIUpdateOperations(d)
 
-	if err != nil {
-		return fmt.Errorf("error updating REST API (%s): %w", d.Id(), err)
+	// A private endpoint requires a resource policy that allows access to it,
+	// so when switching to PRIVATE the policy must be updated first.
+	if d.HasChanges("endpoint_configuration.0.types", "policy") && d.Get("endpoint_configuration.0.types.0").(string) == apigateway.EndpointTypePrivate {
+		var policyOperations []*apigateway.PatchOperation
+		policyOperations, operations = splitRestAPIPolicyUpdateOperations(operations)
+
+		if len(policyOperations) > 0 {
+			_, err := conn.UpdateRestApi(&apigateway.UpdateRestApiInput{
+				RestApiId:       aws.String(d.Id()),
+				PatchOperations: policyOperations,
+			})
+
+			if err != nil {
+				return fmt.Errorf("error updating REST API (%s) policy: %w", d.Id(), err)
+			}
+		}
+	}
+
+	if len(operations) > 0 {
+		_, err := conn.UpdateRestApi(&apigateway.UpdateRestApiInput{
+			RestApiId:       aws.String(d.Id()),
+			PatchOperations: operations,
+		})
+
+		if err != nil {
+			return fmt.Errorf("error updating REST API (%s): %w", d.Id(), err)
+		}
 	}
 
 	return resourceRestAPIRead(d, meta)
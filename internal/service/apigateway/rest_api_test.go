@@ -259,6 +259,45 @@ func TestAccAPIGatewayRestAPI_Endpoint_private(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayRestAPI_Endpoint_privateWithPolicy(t *testing.T) {
+	var restApi apigateway.RestApi
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_rest_api.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigateway.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRestAPIDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRestAPIConfig_EndpointConfiguration(rName, "REGIONAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestAPIExists(resourceName, &restApi),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.0.types.0", "REGIONAL"),
+					resource.TestCheckResourceAttr(resourceName, "policy", ""),
+				),
+			},
+			{
+				Config: testAccRestAPIConfig_EndpointConfigurationPrivateWithPolicy(rName, "execute-api:Invoke"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestAPIExists(resourceName, &restApi),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.0.types.0", "PRIVATE"),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"execute-api:Invoke"`)),
+				),
+			},
+			{
+				Config: testAccRestAPIConfig_EndpointConfigurationPrivateWithPolicy(rName, "execute-api:*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRestAPIExists(resourceName, &restApi),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_configuration.0.types.0", "PRIVATE"),
+					resource.TestMatchResourceAttr(resourceName, "policy", regexp.MustCompile(`"execute-api:\*"`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayRestAPI_apiKeySource(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_api_gateway_rest_api.test"
@@ -1310,6 +1349,30 @@ resource "aws_api_gateway_rest_api" "test" {
 `, rName, endpointType)
 }
 
+func testAccRestAPIConfig_EndpointConfigurationPrivateWithPolicy(rName, action string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name = %[1]q
+
+  endpoint_configuration {
+    types = ["PRIVATE"]
+  }
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = %[2]q
+      Effect = "Allow"
+      Principal = {
+        AWS = "*"
+      }
+      Resource = "*"
+    }]
+  })
+}
+`, rName, action)
+}
+
 func testAccRestAPIDisableExecuteAPIEndpointConfig(rName string, disableExecuteApiEndpoint bool) string {
 	return fmt.Sprintf(`
 resource "aws_api_gateway_rest_api" "test" {
@@ -318,48 +318,8 @@ func resourceUsagePlanUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("api_stages") {
 		o, n := d.GetChange("api_stages")
-		os := o.(*schema.Set).List()
-		ns := n.(*schema.Set).List()
 
-		// Remove every stages associated. Simpler to remove and add new ones,
-		// since there are no replacings.
-		for _, v := range os {
-			m := v.(map[string]interface{})
-			operations = append(operations, &apigateway.PatchOperation{
-				Op:    aws.String(apigateway.OpRemove),
-				Path:  aws.String("/apiStages"),
-				Value: aws.String(fmt.Sprintf("%s:%s", m["api_id"].(string), m["stage"].(string))),
-			})
-		}
-
-		// Handle additions
-		if len(ns) > 0 {
-			for _, v := range ns {
-				m := v.(map[string]interface{})
-				id := fmt.Sprintf("%s:%s", m["api_id"].(string), m["stage"].(string))
-				operations = append(operations, &apigateway.PatchOperation{
-					Op:    aws.String(apigateway.OpAdd),
-					Path:  aws.String("/apiStages"),
-					Value: aws.String(id),
-				})
-				if t, ok := m["throttle"].(*schema.Set); ok && t.Len() > 0 {
-					for _, throttle := range t.List() {
-
-						th := throttle.(map[string]interface{})
-						operations = append(operations, &apigateway.PatchOperation{
-							Op:    aws.String(apigateway.OpReplace),
-							Path:  aws.String(fmt.Sprintf("/apiStages/%s/throttle/%s/rateLimit", id, th["path"].(string))),
-							Value: aws.String(strconv.FormatFloat(th["rate_limit"].(float64), 'f', -1, 64)),
-						})
-						operations = append(operations, &apigateway.PatchOperation{
-							Op:    aws.String(apigateway.OpReplace),
-							Path:  aws.String(fmt.Sprintf("/apiStages/%s/throttle/%s/burstLimit", id, th["path"].(string))),
-							Value: aws.String(strconv.Itoa(th["burst_limit"].(int))),
-						})
-					}
-				}
-			}
-		}
+		operations = append(operations, usagePlanAPIStagesPatchOperations(o.(*schema.Set).List(), n.(*schema.Set).List())...)
 	}
 
 	if d.HasChange("throttle_settings") {
@@ -715,3 +675,103 @@ func flattenUsagePlanApiGatewayApiStagesThrottleSettings(apiObjects map[string]*
 
 	return tfList
 }
+
+// usagePlanAPIStagesPatchOperations returns the patch operations needed to move the plan's
+// API stages from the old to the new configuration. Stages present in both keep their
+// association and only have their per-method throttle settings patched.
+func usagePlanAPIStagesPatchOperations(os, ns []interface{}) []*apigateway.PatchOperation {
+	operations := make([]*apigateway.PatchOperation, 0)
+
+	oldStages := make(map[string]map[string]interface{}, len(os))
+	for _, v := range os {
+		m := v.(map[string]interface{})
+		oldStages[fmt.Sprintf("%s:%s", m["api_id"].(string), m["stage"].(string))] = m
+	}
+
+	newStages := make(map[string]map[string]interface{}, len(ns))
+	for _, v := range ns {
+		m := v.(map[string]interface{})
+		newStages[fmt.Sprintf("%s:%s", m["api_id"].(string), m["stage"].(string))] = m
+	}
+
+	// Handle removals.
+	for _, v := range os {
+		m := v.(map[string]interface{})
+		id := fmt.Sprintf("%s:%s", m["api_id"].(string), m["stage"].(string))
+
+		if _, ok := newStages[id]; ok {
+			continue
+		}
+
+		operations = append(operations, &apigateway.PatchOperation{
+			Op:    aws.String(apigateway.OpRemove),
+			Path:  aws.String("/apiStages"),
+			Value: aws.String(id),
+		})
+	}
+
+	// Handle additions and throttle changes on existing stages.
+	for _, v := range ns {
+		m := v.(map[string]interface{})
+		id := fmt.Sprintf("%s:%s", m["api_id"].(string), m["stage"].(string))
+
+		var oldThrottleList []interface{}
+		oldThrottles := make(map[string]map[string]interface{})
+
+		if old, ok := oldStages[id]; ok {
+			if t, ok := old["throttle"].(*schema.Set); ok {
+				oldThrottleList = t.List()
+				for _, throttle := range oldThrottleList {
+					th := throttle.(map[string]interface{})
+					oldThrottles[th["path"].(string)] = th
+				}
+			}
+		} else {
+			operations = append(operations, &apigateway.PatchOperation{
+				Op:    aws.String(apigateway.OpAdd),
+				Path:  aws.String("/apiStages"),
+				Value: aws.String(id),
+			})
+		}
+
+		newThrottles := make(map[string]bool)
+
+		if t, ok := m["throttle"].(*schema.Set); ok {
+			for _, throttle := range t.List() {
+				th := throttle.(map[string]interface{})
+				path := th["path"].(string)
+				newThrottles[path] = true
+
+				if old, ok := oldThrottles[path]; ok && old["rate_limit"].(float64) == th["rate_limit"].(float64) && old["burst_limit"].(int) == th["burst_limit"].(int) {
+					continue
+				}
+
+				operations = append(operations, &apigateway.PatchOperation{
+					Op:    aws.String(apigateway.OpReplace),
+					Path:  aws.String(fmt.Sprintf("/apiStages/%s/throttle/%s/rateLimit", id, path)),
+					Value: aws.String(strconv.FormatFloat(th["rate_limit"].(float64), 'f', -1, 64)),
+				})
+				operations = append(operations, &apigateway.PatchOperation{
+					Op:    aws.String(apigateway.OpReplace),
+					Path:  aws.String(fmt.Sprintf("/apiStages/%s/throttle/%s/burstLimit", id, path)),
+					Value: aws.String(strconv.Itoa(th["burst_limit"].(int))),
+				})
+			}
+		}
+
+		for _, throttle := range oldThrottleList {
+			path := throttle.(map[string]interface{})["path"].(string)
+
+			if newThrottles[path] {
+				continue
+			}
+
+			operations = append(operations, &apigateway.PatchOperation{
+				Op:   aws.String(apigateway.OpRemove),
+				Path: aws.String(fmt.Sprintf("/apiStages/%s/throttle/%s", id, path)),
+			})
+		}
+	}
+
+	return operations
+}
@@ -0,0 +1,186 @@
+package apigateway
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// There are no constants in the SDK for this value.
+	usagePlanKeyTypeAPIKey = "API_KEY"
+)
+
+func ResourceUsagePlanKeys() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUsagePlanKeysCreate,
+		Read:   resourceUsagePlanKeysRead,
+		Update: resourceUsagePlanKeysUpdate,
+		Delete: resourceUsagePlanKeysDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      usagePlanKeyTypeAPIKey,
+				ValidateFunc: validation.StringInSlice([]string{usagePlanKeyTypeAPIKey}, false),
+			},
+			"usage_plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUsagePlanKeysCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayConn
+
+	usagePlanID := d.Get("usage_plan_id").(string)
+
+	keys, err := FindUsagePlanKeysByUsagePlanID(conn, usagePlanID)
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway Usage Plan (%s) keys: %w", usagePlanID, err)
+	}
+
+	// Set the ID first so that keys attached before a failure are tracked in state.
+	d.SetId(usagePlanID)
+
+	// This resource is authoritative, so remove any keys already attached to the usage plan that aren't configured.
+	os := schema.NewSet(schema.HashString, nil)
+	for _, key := range keys {
+		os.Add(aws.StringValue(key.Id))
+	}
+	ns := d.Get("key_ids").(*schema.Set)
+
+	if err := deleteUsagePlanKeys(conn, usagePlanID, os.Difference(ns).List()); err != nil {
+		return err
+	}
+
+	if err := createUsagePlanKeys(conn, usagePlanID, d.Get("key_type").(string), ns.Difference(os).List()); err != nil {
+		return err
+	}
+
+	return resourceUsagePlanKeysRead(d, meta)
+}
+
+func resourceUsagePlanKeysRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayConn
+
+	keys, err := FindUsagePlanKeysByUsagePlanID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] API Gateway Usage Plan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading API Gateway Usage Plan (%s) keys: %w", d.Id(), err)
+	}
+
+	var keyIDs []string
+	keyType := usagePlanKeyTypeAPIKey
+
+	for _, key := range keys {
+		keyIDs = append(keyIDs, aws.StringValue(key.Id))
+		keyType = aws.StringValue(key.Type)
+	}
+
+	d.Set("key_ids", keyIDs)
+	d.Set("key_type", keyType)
+	d.Set("usage_plan_id", d.Id())
+
+	return nil
+}
+
+func resourceUsagePlanKeysUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayConn
+
+	if d.HasChange("key_ids") {
+		o, n := d.GetChange("key_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if err := deleteUsagePlanKeys(conn, d.Id(), os.Difference(ns).List()); err != nil {
+			return err
+		}
+
+		if err := createUsagePlanKeys(conn, d.Id(), d.Get("key_type").(string), ns.Difference(os).List()); err != nil {
+			return err
+		}
+	}
+
+	return resourceUsagePlanKeysRead(d, meta)
+}
+
+func resourceUsagePlanKeysDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).APIGatewayConn
+
+	return deleteUsagePlanKeys(conn, d.Id(), d.Get("key_ids").(*schema.Set).List())
+}
+
+func createUsagePlanKeys(conn *apigateway.APIGateway, usagePlanID, keyType string, keyIDs []interface{}) error {
+	for _, v := range keyIDs {
+		keyID := v.(string)
+		input := &apigateway.CreateUsagePlanKeyInput{
+			KeyId:       aws.String(keyID),
+			KeyType:     aws.String(keyType),
+			UsagePlanId: aws.String(usagePlanID),
+		}
+
+		log.Printf("[DEBUG] Creating API Gateway Usage Plan Key: %s", input)
+		_, err := conn.CreateUsagePlanKey(input)
+
+		// The key is already attached to the usage plan.
+		if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeConflictException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error adding key (%s) to API Gateway Usage Plan (%s): %w", keyID, usagePlanID, err)
+		}
+	}
+
+	return nil
+}
+
+func deleteUsagePlanKeys(conn *apigateway.APIGateway, usagePlanID string, keyIDs []interface{}) error {
+	for _, v := range keyIDs {
+		keyID := v.(string)
+
+		log.Printf("[DEBUG] Deleting API Gateway Usage Plan (%s) Key: %s", usagePlanID, keyID)
+		_, err := conn.DeleteUsagePlanKey(&apigateway.DeleteUsagePlanKeyInput{
+			KeyId:       aws.String(keyID),
+			UsagePlanId: aws.String(usagePlanID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, apigateway.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error removing key (%s) from API Gateway Usage Plan (%s): %w", keyID, usagePlanID, err)
+		}
+	}
+
+	return nil
+}
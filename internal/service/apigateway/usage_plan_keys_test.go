@@ -0,0 +1,198 @@
+package apigateway_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apigateway"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapigateway "github.com/hashicorp/terraform-provider-aws/internal/service/apigateway"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAPIGatewayUsagePlanKeys_basic(t *testing.T) {
+	var keys []*apigateway.UsagePlanKey
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	apiGatewayUsagePlanResourceName := "aws_api_gateway_usage_plan.test"
+	resourceName := "aws_api_gateway_usage_plan_keys.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigateway.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckUsagePlanKeysDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsagePlanKeysConfig(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsagePlanKeysExists(resourceName, &keys),
+					resource.TestCheckResourceAttr(resourceName, "key_ids.#", "3"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "key_ids.*", "aws_api_gateway_api_key.test.0", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "key_ids.*", "aws_api_gateway_api_key.test.1", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "key_ids.*", "aws_api_gateway_api_key.test.2", "id"),
+					resource.TestCheckResourceAttr(resourceName, "key_type", "API_KEY"),
+					resource.TestCheckResourceAttrPair(resourceName, "usage_plan_id", apiGatewayUsagePlanResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayUsagePlanKeys_disappears(t *testing.T) {
+	var keys []*apigateway.UsagePlanKey
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_usage_plan_keys.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigateway.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckUsagePlanKeysDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsagePlanKeysConfig(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsagePlanKeysExists(resourceName, &keys),
+					acctest.CheckResourceDisappears(acctest.Provider, tfapigateway.ResourceUsagePlanKeys(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayUsagePlanKeys_update(t *testing.T) {
+	var keys []*apigateway.UsagePlanKey
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_usage_plan_keys.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, apigateway.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckUsagePlanKeysDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsagePlanKeysConfig(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsagePlanKeysExists(resourceName, &keys),
+					testAccCheckUsagePlanKeysCount(&keys, 2),
+					resource.TestCheckResourceAttr(resourceName, "key_ids.#", "2"),
+				),
+			},
+			{
+				Config: testAccUsagePlanKeysConfig(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsagePlanKeysExists(resourceName, &keys),
+					testAccCheckUsagePlanKeysCount(&keys, 5),
+					resource.TestCheckResourceAttr(resourceName, "key_ids.#", "5"),
+				),
+			},
+			{
+				Config: testAccUsagePlanKeysConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsagePlanKeysExists(resourceName, &keys),
+					testAccCheckUsagePlanKeysCount(&keys, 1),
+					resource.TestCheckResourceAttr(resourceName, "key_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "key_ids.*", "aws_api_gateway_api_key.test.0", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUsagePlanKeysExists(n string, v *[]*apigateway.UsagePlanKey) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No API Gateway Usage Plan ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn
+
+		output, err := tfapigateway.FindUsagePlanKeysByUsagePlanID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = output
+
+		return nil
+	}
+}
+
+func testAccCheckUsagePlanKeysCount(v *[]*apigateway.UsagePlanKey, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := len(*v); got != expected {
+			return fmt.Errorf("expected %d API Gateway Usage Plan Keys, got %d", expected, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckUsagePlanKeysDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_api_gateway_usage_plan_keys" {
+			continue
+		}
+
+		output, err := tfapigateway.FindUsagePlanKeysByUsagePlanID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) > 0 {
+			return fmt.Errorf("API Gateway Usage Plan (%s) still has %d keys", rs.Primary.ID, len(output))
+		}
+	}
+
+	return nil
+}
+
+func testAccUsagePlanKeysConfig(rName string, count int) string {
+	return acctest.ConfigCompose(
+		testAccUsagePlanKeyBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_api_gateway_api_key" "test" {
+  count = %[2]d
+
+  name = "%[1]s-${count.index}"
+}
+
+resource "aws_api_gateway_usage_plan" "test" {
+  name = %[1]q
+
+  api_stages {
+    api_id = aws_api_gateway_rest_api.test.id
+    stage  = aws_api_gateway_deployment.test.stage_name
+  }
+}
+
+resource "aws_api_gateway_usage_plan_keys" "test" {
+  key_ids       = aws_api_gateway_api_key.test[*].id
+  usage_plan_id = aws_api_gateway_usage_plan.test.id
+}
+`, rName, count))
+}
@@ -510,6 +510,27 @@ func TestAccAPIGatewayUsagePlan_APIStages_throttle(t *testing.T) {
 					}),
 				),
 			},
+			{
+				Config: testAccUsagePlanAPIStagesConfigThrottleMultiModified(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsagePlanExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "api_stages.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "api_stages.*", map[string]string{
+						"stage":      "foo",
+						"throttle.#": "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "api_stages.*", map[string]string{
+						"stage":      "test",
+						"throttle.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "api_stages.*.throttle.*", map[string]string{
+						"path":        "/test/GET",
+						"burst_limit": "5",
+						"rate_limit":  "10",
+					}),
+				),
+			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
@@ -848,6 +869,35 @@ resource "aws_api_gateway_usage_plan" "test" {
 `, rName)
 }
 
+func testAccUsagePlanAPIStagesConfigThrottleMultiModified(rName string) string {
+	return testAccUsagePlanConfig(rName) + fmt.Sprintf(`
+resource "aws_api_gateway_usage_plan" "test" {
+  name = "%s"
+
+  throttle_settings {
+    burst_limit = 3
+    rate_limit  = 6
+  }
+
+  api_stages {
+    api_id = aws_api_gateway_rest_api.test.id
+    stage  = aws_api_gateway_deployment.test.stage_name
+
+    throttle {
+      path        = "${aws_api_gateway_resource.test.path}/${aws_api_gateway_method.test.http_method}"
+      burst_limit = 5
+      rate_limit  = 10
+    }
+  }
+
+  api_stages {
+    api_id = aws_api_gateway_rest_api.test.id
+    stage  = aws_api_gateway_deployment.foo.stage_name
+  }
+}
+`, rName)
+}
+
 func testAccUsagePlanAPIStagesModifiedConfig(rName string) string {
 	return testAccUsagePlanConfig(rName) + fmt.Sprintf(`
 resource "aws_api_gateway_usage_plan" "test" {
@@ -72,6 +72,37 @@ func FindAPIs(conn *apigatewayv2.ApiGatewayV2, input *apigatewayv2.GetApisInput)
 	return apis, nil
 }
 
+// FindStageByName returns the Stage corresponding to the specified API ID and name.
+// Returns NotFoundError if no Stage is found.
+func FindStageByName(conn *apigatewayv2.ApiGatewayV2, apiID, name string) (*apigatewayv2.GetStageOutput, error) {
+	input := &apigatewayv2.GetStageInput{
+		ApiId:     aws.String(apiID),
+		StageName: aws.String(name),
+	}
+
+	output, err := conn.GetStage(input)
+
+	if tfawserr.ErrCodeEquals(err, apigatewayv2.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindDomainNameByName(conn *apigatewayv2.ApiGatewayV2, name string) (*apigatewayv2.GetDomainNameOutput, error) {
 	input := &apigatewayv2.GetDomainNameInput{
 		DomainName: aws.String(name),
@@ -126,6 +126,10 @@ func ResourceStage() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"last_deployment_status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -238,6 +242,12 @@ func resourceStageCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(aws.StringValue(resp.StageName))
 
+	if d.Get("auto_deploy").(bool) {
+		if _, err := WaitStageAutoDeploymentDeployed(conn, apiId, d.Id(), nil); err != nil {
+			return fmt.Errorf("error waiting for API Gateway v2 stage (%s) automatic deployment: %w", d.Id(), err)
+		}
+	}
+
 	return resourceStageRead(d, meta)
 }
 
@@ -289,6 +299,7 @@ func resourceStageRead(d *schema.ResourceData, meta interface{}) error {
 		Resource:  fmt.Sprintf("%s/%s", apiId, stageName),
 	}.String()
 	d.Set("execution_arn", executionArn)
+	d.Set("last_deployment_status_message", resp.LastDeploymentStatusMessage)
 	d.Set("name", stageName)
 	err = d.Set("route_settings", flattenApiGatewayV2RouteSettings(resp.RouteSettings))
 	if err != nil {
@@ -348,6 +359,18 @@ func resourceStageUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		protocolType := aws.StringValue(apiOutput.ProtocolType)
 
+		// Enabling automatic deployment deploys the stage, so note the current deployment to wait for a new one.
+		var previousStage *apigatewayv2.GetStageOutput
+		waitForAutoDeployment := d.HasChange("auto_deploy") && d.Get("auto_deploy").(bool)
+
+		if waitForAutoDeployment {
+			previousStage, err = FindStageByName(conn, apiId, d.Id())
+
+			if err != nil {
+				return fmt.Errorf("error reading API Gateway v2 stage (%s): %w", d.Id(), err)
+			}
+		}
+
 		req := &apigatewayv2.UpdateStageInput{
 			ApiId:     aws.String(apiId),
 			StageName: aws.String(d.Id()),
@@ -413,6 +436,12 @@ func resourceStageUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return fmt.Errorf("error updating API Gateway v2 stage (%s): %s", d.Id(), err)
 		}
+
+		if waitForAutoDeployment {
+			if _, err := WaitStageAutoDeploymentDeployed(conn, apiId, d.Id(), previousStage); err != nil {
+				return fmt.Errorf("error waiting for API Gateway v2 stage (%s) automatic deployment: %w", d.Id(), err)
+			}
+		}
 	}
 
 	if d.HasChange("tags_all") {
@@ -3,9 +3,12 @@ package apigatewayv2_test
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -17,6 +20,135 @@ import (
 	tfapigatewayv2 "github.com/hashicorp/terraform-provider-aws/internal/service/apigatewayv2"
 )
 
+func TestStatusStageDeployment(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Previous         *apigatewayv2.GetStageOutput
+		Stage            *apigatewayv2.GetStageOutput
+		Deployment       *apigatewayv2.GetDeploymentOutput
+		ExpectedState    string
+		ExpectedErrorMsg string
+	}{
+		{
+			Name:          "deployment not yet created",
+			Stage:         &apigatewayv2.GetStageOutput{},
+			ExpectedState: "",
+		},
+		{
+			Name: "deployment not created",
+			Stage: &apigatewayv2.GetStageOutput{
+				LastDeploymentStatusMessage: aws.String("Unable to deploy API because no routes exist in this API"),
+			},
+			ExpectedState: "NONE",
+		},
+		{
+			Name: "deployment pending",
+			Stage: &apigatewayv2.GetStageOutput{
+				DeploymentId: aws.String("abc123"),
+			},
+			Deployment: &apigatewayv2.GetDeploymentOutput{
+				DeploymentStatus: aws.String(apigatewayv2.DeploymentStatusPending),
+			},
+			ExpectedState: apigatewayv2.DeploymentStatusPending,
+		},
+		{
+			Name: "deployment deployed",
+			Stage: &apigatewayv2.GetStageOutput{
+				DeploymentId: aws.String("abc123"),
+			},
+			Deployment: &apigatewayv2.GetDeploymentOutput{
+				DeploymentStatus: aws.String(apigatewayv2.DeploymentStatusDeployed),
+			},
+			ExpectedState: apigatewayv2.DeploymentStatusDeployed,
+		},
+		{
+			Name: "deployment failed",
+			Stage: &apigatewayv2.GetStageOutput{
+				DeploymentId:                aws.String("abc123"),
+				LastDeploymentStatusMessage: aws.String("stage status message"),
+			},
+			Deployment: &apigatewayv2.GetDeploymentOutput{
+				DeploymentStatus:        aws.String(apigatewayv2.DeploymentStatusFailed),
+				DeploymentStatusMessage: aws.String("deployment status message"),
+			},
+			ExpectedState:    apigatewayv2.DeploymentStatusFailed,
+			ExpectedErrorMsg: "deployment (abc123): stage status message",
+		},
+		{
+			Name: "previous deployment unchanged",
+			Previous: &apigatewayv2.GetStageOutput{
+				DeploymentId:                aws.String("abc123"),
+				LastDeploymentStatusMessage: aws.String("Successfully deployed stage with deployment ID 'abc123'"),
+			},
+			Stage: &apigatewayv2.GetStageOutput{
+				DeploymentId:                aws.String("abc123"),
+				LastDeploymentStatusMessage: aws.String("Successfully deployed stage with deployment ID 'abc123'"),
+			},
+			ExpectedState: "",
+		},
+		{
+			Name: "previous deployment kept after failure",
+			Previous: &apigatewayv2.GetStageOutput{
+				DeploymentId:                aws.String("abc123"),
+				LastDeploymentStatusMessage: aws.String("Successfully deployed stage with deployment ID 'abc123'"),
+			},
+			Stage: &apigatewayv2.GetStageOutput{
+				DeploymentId:                aws.String("abc123"),
+				LastDeploymentStatusMessage: aws.String("Unable to deploy API because no routes exist in this API"),
+			},
+			ExpectedState: "NONE",
+		},
+		{
+			Name: "new deployment deployed",
+			Previous: &apigatewayv2.GetStageOutput{
+				DeploymentId: aws.String("abc123"),
+			},
+			Stage: &apigatewayv2.GetStageOutput{
+				DeploymentId: aws.String("def456"),
+			},
+			Deployment: &apigatewayv2.GetDeploymentOutput{
+				DeploymentStatus: aws.String(apigatewayv2.DeploymentStatusDeployed),
+			},
+			ExpectedState: apigatewayv2.DeploymentStatusDeployed,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := apigatewayv2.New(sess)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				switch data := r.Data.(type) {
+				case *apigatewayv2.GetStageOutput:
+					*data = *testCase.Stage
+				case *apigatewayv2.GetDeploymentOutput:
+					*data = *testCase.Deployment
+				}
+			})
+
+			_, state, err := tfapigatewayv2.StatusStageDeployment(conn, "api123", "test", testCase.Previous)()
+
+			if state != testCase.ExpectedState {
+				t.Errorf("expected state %q, got %q", testCase.ExpectedState, state)
+			}
+
+			if testCase.ExpectedErrorMsg == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), testCase.ExpectedErrorMsg) {
+				t.Fatalf("expected error containing %q, got %v", testCase.ExpectedErrorMsg, err)
+			}
+		})
+	}
+}
+
 func TestAccAPIGatewayV2Stage_basicWebSocket(t *testing.T) {
 	var apiId string
 	var v apigatewayv2.GetStageOutput
@@ -205,11 +337,11 @@ func TestAccAPIGatewayV2Stage_autoDeployHTTP(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "default_route_settings.0.logging_level", ""),
 					resource.TestCheckResourceAttr(resourceName, "default_route_settings.0.throttling_burst_limit", "0"),
 					resource.TestCheckResourceAttr(resourceName, "default_route_settings.0.throttling_rate_limit", "0"),
-					// The stage's DeploymentId attribute will be set asynchronously as deployments are done automatically.
-					// resource.TestCheckResourceAttrSet(resourceName, "deployment_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "deployment_id"),
 					resource.TestCheckResourceAttr(resourceName, "description", ""),
 					testAccCheckStageExecutionARN(resourceName, "execution_arn", &apiId, &v),
 					resource.TestMatchResourceAttr(resourceName, "invoke_url", regexp.MustCompile(fmt.Sprintf("https://.+\\.execute-api\\.%s.amazonaws\\.com/%s", acctest.Region(), rName))),
+					resource.TestCheckResourceAttrSet(resourceName, "last_deployment_status_message"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "route_settings.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "stage_variables.%", "0"),
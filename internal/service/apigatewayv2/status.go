@@ -34,6 +34,56 @@ func StatusDeployment(conn *apigatewayv2.ApiGatewayV2, apiId, deploymentId strin
 	}
 }
 
+// stageDeploymentStatusNone is the status of a Stage whose automatic Deployment failed before being created.
+const stageDeploymentStatusNone = "NONE"
+
+// StatusStageDeployment fetches the Stage and the Status of its current Deployment.
+// If previous is set, the Stage's Deployment must differ from the previous Stage's.
+func StatusStageDeployment(conn *apigatewayv2.ApiGatewayV2, apiId, stageName string, previous *apigatewayv2.GetStageOutput) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		stage, err := FindStageByName(conn, apiId, stageName)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		deploymentId := aws.StringValue(stage.DeploymentId)
+
+		if deploymentId == "" || (previous != nil && deploymentId == aws.StringValue(previous.DeploymentId)) {
+			// An automatic deployment that fails before it is created (e.g. no routes) only leaves a status message.
+			statusMessage := aws.StringValue(stage.LastDeploymentStatusMessage)
+
+			if statusMessage != "" && (previous == nil || statusMessage != aws.StringValue(previous.LastDeploymentStatusMessage)) {
+				log.Printf("[WARN] API Gateway v2 stage (%s) has no new deployment: %s", stageName, statusMessage)
+				return stage, stageDeploymentStatusNone, nil
+			}
+
+			return stage, "", nil
+		}
+
+		output, err := conn.GetDeployment(&apigatewayv2.GetDeploymentInput{
+			ApiId:        aws.String(apiId),
+			DeploymentId: aws.String(deploymentId),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if aws.StringValue(output.DeploymentStatus) == apigatewayv2.DeploymentStatusFailed {
+			statusMessage := aws.StringValue(stage.LastDeploymentStatusMessage)
+
+			if statusMessage == "" {
+				statusMessage = aws.StringValue(output.DeploymentStatusMessage)
+			}
+
+			return stage, apigatewayv2.DeploymentStatusFailed, fmt.Errorf("deployment (%s): %s", deploymentId, statusMessage)
+		}
+
+		return stage, aws.StringValue(output.DeploymentStatus), nil
+	}
+}
+
 func StatusDomainName(conn *apigatewayv2.ApiGatewayV2, name string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		domainName, err := FindDomainNameByName(conn, name)
@@ -36,6 +36,25 @@ func WaitDeploymentDeployed(conn *apigatewayv2.ApiGatewayV2, apiId, deploymentId
 	return nil, err
 }
 
+// WaitStageAutoDeploymentDeployed waits for a Stage's automatic Deployment to return Deployed.
+// If previous is set, waits for a Deployment other than the previous Stage's.
+func WaitStageAutoDeploymentDeployed(conn *apigatewayv2.ApiGatewayV2, apiId, stageName string, previous *apigatewayv2.GetStageOutput) (*apigatewayv2.GetStageOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"", apigatewayv2.DeploymentStatusPending},
+		Target:  []string{apigatewayv2.DeploymentStatusDeployed, stageDeploymentStatusNone},
+		Refresh: StatusStageDeployment(conn, apiId, stageName, previous),
+		Timeout: DeploymentDeployedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*apigatewayv2.GetStageOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
 func WaitDomainNameAvailable(conn *apigatewayv2.ApiGatewayV2, name string, timeout time.Duration) (*apigatewayv2.GetDomainNameOutput, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{apigatewayv2.DomainNameStatusUpdating},
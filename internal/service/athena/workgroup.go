@@ -456,8 +456,8 @@ func expandAthenaWorkGroupResultConfigurationUpdates(l []interface{}) *athena.Re
 
 	resultConfigurationUpdates := &athena.ResultConfigurationUpdates{}
 
-	if v, ok := m["encryption_configuration"]; ok {
-		resultConfigurationUpdates.EncryptionConfiguration = expandAthenaWorkGroupEncryptionConfiguration(v.([]interface{}))
+	if v, ok := m["encryption_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		resultConfigurationUpdates.EncryptionConfiguration = expandAthenaWorkGroupEncryptionConfiguration(v)
 	} else {
 		resultConfigurationUpdates.RemoveEncryptionConfiguration = aws.Bool(true)
 	}
@@ -474,8 +474,8 @@ func expandAthenaWorkGroupResultConfigurationUpdates(l []interface{}) *athena.Re
 		resultConfigurationUpdates.RemoveExpectedBucketOwner = aws.Bool(true)
 	}
 
-	if v, ok := m["acl_configuration"]; ok {
-		resultConfigurationUpdates.AclConfiguration = expandAthenaResultConfigurationAclConfig(v.([]interface{}))
+	if v, ok := m["acl_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		resultConfigurationUpdates.AclConfiguration = expandAthenaResultConfigurationAclConfig(v)
 	} else {
 		resultConfigurationUpdates.RemoveAclConfiguration = aws.Bool(true)
 	}
@@ -55,8 +55,9 @@ func TestAccAthenaWorkGroup_basic(t *testing.T) {
 }
 
 func TestAccAthenaWorkGroup_aclConfig(t *testing.T) {
-	var workgroup1 athena.WorkGroup
+	var workgroup1, workgroup2 athena.WorkGroup
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_athena_workgroup.test"
 
 	resource.ParallelTest(t, resource.TestCase{
@@ -82,6 +83,15 @@ func TestAccAthenaWorkGroup_aclConfig(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"force_destroy"},
 			},
+			{
+				Config: testAccAthenaWorkGroupConfigConfigurationResultConfigurationOutputLocation(rName, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkGroupExists(resourceName, &workgroup2),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.result_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.result_configuration.0.acl_configuration.#", "0"),
+				),
+			},
 		},
 	})
 }
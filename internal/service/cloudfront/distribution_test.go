@@ -1451,7 +1451,7 @@ func testAccCheckDistributionDisappears(distribution *cloudfront.Distribution) r
 
 func testAccCheckDistributionWaitForDeployment(distribution *cloudfront.Distribution) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		return tfcloudfront.DistributionWaitUntilDeployed(aws.StringValue(distribution.Id), acctest.Provider.Meta())
+		return tfcloudfront.DistributionWaitUntilDeployed(aws.StringValue(distribution.Id), acctest.Provider.Meta(), 90*time.Minute)
 	}
 }
 
@@ -0,0 +1,99 @@
+package cloudfront
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceFunctionTest() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFunctionTestRead,
+
+		Schema: map[string]*schema.Schema{
+			"compute_utilization": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"event_object": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+
+			"function_error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"function_execution_logs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"function_output": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"stage": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      cloudfront.FunctionStageDevelopment,
+				ValidateFunc: validation.StringInSlice(cloudfront.FunctionStage_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceFunctionTestRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).CloudFrontConn
+
+	name := d.Get("name").(string)
+	stage := d.Get("stage").(string)
+
+	// TestFunction requires the ETag of the function in the stage under test.
+	describeFunctionOutput, err := FindFunctionByNameAndStage(conn, name, stage)
+
+	if err != nil {
+		return fmt.Errorf("error describing CloudFront Function (%s/%s): %w", name, stage, err)
+	}
+
+	input := &cloudfront.TestFunctionInput{
+		EventObject: []byte(d.Get("event_object").(string)),
+		IfMatch:     describeFunctionOutput.ETag,
+		Name:        aws.String(name),
+		Stage:       aws.String(stage),
+	}
+
+	output, err := conn.TestFunction(input)
+
+	if err != nil {
+		return fmt.Errorf("error testing CloudFront Function (%s/%s): %w", name, stage, err)
+	}
+
+	if output == nil || output.TestResult == nil {
+		return fmt.Errorf("error testing CloudFront Function (%s/%s): empty result", name, stage)
+	}
+
+	result := output.TestResult
+
+	d.SetId(name)
+	d.Set("compute_utilization", result.ComputeUtilization)
+	d.Set("function_error_message", result.FunctionErrorMessage)
+	d.Set("function_execution_logs", aws.StringValueSlice(result.FunctionExecutionLogs))
+	d.Set("function_output", result.FunctionOutput)
+
+	return nil
+}
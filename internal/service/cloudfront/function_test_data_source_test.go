@@ -0,0 +1,79 @@
+package cloudfront_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudFrontFunctionTestDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudfront_function_test.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(cloudfront.EndpointsID, t) },
+		ErrorCheck: acctest.ErrorCheck(t, cloudfront.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionTestDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "compute_utilization", regexp.MustCompile(`^\d+$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "function_error_message", ""),
+					resource.TestMatchResourceAttr(dataSourceName, "function_output", regexp.MustCompile(`"statusCode":302`)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", "aws_cloudfront_function.test", "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "stage", "DEVELOPMENT"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFunctionTestDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_function" "test" {
+  name    = %[1]q
+  runtime = "cloudfront-js-1.0"
+  comment = "test"
+  code    = <<-EOT
+function handler(event) {
+	var response = {
+		statusCode: 302,
+		statusDescription: 'Found',
+		headers: {
+			'cloudfront-functions': { value: 'generated-by-CloudFront-Functions' },
+			'location': { value: 'https://aws.amazon.com/cloudfront/' }
+		}
+	};
+	return response;
+}
+EOT
+}
+
+data "aws_cloudfront_function_test" "test" {
+  name = aws_cloudfront_function.test.name
+
+  event_object = jsonencode({
+    version = "1.0"
+    context = {
+      eventType = "viewer-request"
+    }
+    viewer = {
+      ip = "198.51.100.11"
+    }
+    request = {
+      method      = "GET"
+      uri         = "/index.html"
+      headers     = {}
+      cookies     = {}
+      querystring = {}
+    }
+  })
+}
+`, rName)
+}
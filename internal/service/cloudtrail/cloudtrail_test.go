@@ -19,21 +19,22 @@ import (
 func TestAccCloudTrail_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"Trail": {
-			"basic":                 testAcc_basic,
-			"cloudwatch":            testAcc_cloudWatch,
-			"enableLogging":         testAcc_enableLogging,
-			"globalServiceEvents":   testAcc_globalServiceEvents,
-			"multiRegion":           testAcc_multiRegion,
-			"organization":          testAcc_organization,
-			"logValidation":         testAcc_logValidation,
-			"kmsKey":                testAcc_kmsKey,
-			"tags":                  testAcc_tags,
-			"eventSelector":         testAcc_eventSelector,
-			"eventSelectorDynamoDB": testAcc_eventSelectorDynamoDB,
-			"eventSelectorExclude":  testAcc_eventSelectorExclude,
-			"insightSelector":       testAcc_insightSelector,
-			"advancedEventSelector": testAcc_advanced_event_selector,
-			"disappears":            testAcc_disappears,
+			"basic":                         testAcc_basic,
+			"cloudwatch":                    testAcc_cloudWatch,
+			"enableLogging":                 testAcc_enableLogging,
+			"globalServiceEvents":           testAcc_globalServiceEvents,
+			"multiRegion":                   testAcc_multiRegion,
+			"organization":                  testAcc_organization,
+			"logValidation":                 testAcc_logValidation,
+			"kmsKey":                        testAcc_kmsKey,
+			"tags":                          testAcc_tags,
+			"eventSelector":                 testAcc_eventSelector,
+			"eventSelectorDynamoDB":         testAcc_eventSelectorDynamoDB,
+			"eventSelectorExclude":          testAcc_eventSelectorExclude,
+			"insightSelector":               testAcc_insightSelector,
+			"advancedEventSelector":         testAcc_advanced_event_selector,
+			"advancedEventSelectorPatterns": testAcc_advancedEventSelectorPatterns,
+			"disappears":                    testAcc_disappears,
 		},
 	}
 
@@ -723,6 +724,49 @@ func testAcc_advanced_event_selector(t *testing.T) {
 	})
 }
 
+func testAcc_advancedEventSelectorPatterns(t *testing.T) {
+	resourceName := "aws_cloudtrail.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudtrail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig_advancedEventSelectorPatterns(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "advanced_event_selector.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_event_selector.0.name", "s3PrefixEvents"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_event_selector.0.field_selector.#", "4"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "advanced_event_selector.0.field_selector.*", map[string]string{
+						"field":         "resources.ARN",
+						"starts_with.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "advanced_event_selector.0.field_selector.*", map[string]string{
+						"field":             "eventName",
+						"not_starts_with.#": "1",
+						"not_starts_with.0": "Get",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "advanced_event_selector.1.name", "lambdaSuffixEvents"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_event_selector.1.field_selector.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "advanced_event_selector.1.field_selector.*", map[string]string{
+						"field":           "resources.ARN",
+						"not_ends_with.#": "1",
+						"not_ends_with.0": ":function:excluded",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAcc_disappears(t *testing.T) {
 	var trail cloudtrail.Trail
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1615,3 +1659,98 @@ resource "aws_s3_bucket" "test2" {
 }
 `, rName)
 }
+
+func testAccConfig_advancedEventSelectorPatterns(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudtrail" "test" {
+  # Must have bucket policy attached first
+  depends_on = [aws_s3_bucket_policy.test]
+
+  name           = %[1]q
+  s3_bucket_name = aws_s3_bucket.test1.id
+
+  advanced_event_selector {
+    name = "s3PrefixEvents"
+    field_selector {
+      field  = "eventCategory"
+      equals = ["Data"]
+    }
+
+    field_selector {
+      field           = "eventName"
+      not_starts_with = ["Get"]
+    }
+
+    field_selector {
+      field       = "resources.ARN"
+      starts_with = ["${aws_s3_bucket.test2.arn}/logs/"]
+    }
+
+    field_selector {
+      field  = "resources.type"
+      equals = ["AWS::S3::Object"]
+    }
+  }
+
+  advanced_event_selector {
+    name = "lambdaSuffixEvents"
+    field_selector {
+      field  = "eventCategory"
+      equals = ["Data"]
+    }
+
+    field_selector {
+      field         = "resources.ARN"
+      not_ends_with = [":function:excluded"]
+    }
+
+    field_selector {
+      field  = "resources.type"
+      equals = ["AWS::Lambda::Function"]
+    }
+  }
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test1" {
+  bucket        = "%[1]s-1"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test1.id
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AWSCloudTrailAclCheck",
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "s3:GetBucketAcl",
+      "Resource": "arn:${data.aws_partition.current.partition}:s3:::%[1]s-1"
+    },
+    {
+      "Sid": "AWSCloudTrailWrite",
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "s3:PutObject",
+      "Resource": "arn:${data.aws_partition.current.partition}:s3:::%[1]s-1/*",
+      "Condition": {
+        "StringEquals": {
+          "s3:x-amz-acl": "bucket-owner-full-control"
+        }
+      }
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_s3_bucket" "test2" {
+  bucket        = "%[1]s-2"
+  force_destroy = true
+}
+`, rName)
+}
@@ -132,6 +132,10 @@ func ResourceEventDataStore() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"created_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"multi_region_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -156,6 +160,10 @@ func ResourceEventDataStore() *schema.Resource {
 					validation.IntBetween(7, 2555),
 				),
 			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"termination_protection_enabled": {
@@ -226,10 +234,16 @@ func resourceEventDataStoreRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.Errorf("error setting advanced_event_selector: %s", err)
 	}
 	d.Set("arn", eventDataStore.EventDataStoreArn)
+	if eventDataStore.CreatedTimestamp != nil {
+		d.Set("created_timestamp", aws.TimeValue(eventDataStore.CreatedTimestamp).Format(time.RFC3339))
+	} else {
+		d.Set("created_timestamp", nil)
+	}
 	d.Set("multi_region_enabled", eventDataStore.MultiRegionEnabled)
 	d.Set("name", eventDataStore.Name)
 	d.Set("organization_enabled", eventDataStore.OrganizationEnabled)
 	d.Set("retention_period", eventDataStore.RetentionPeriod)
+	d.Set("status", eventDataStore.Status)
 	d.Set("termination_protection_enabled", eventDataStore.TerminationProtectionEnabled)
 
 	tags, err := ListTags(conn, d.Id())
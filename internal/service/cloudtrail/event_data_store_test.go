@@ -39,10 +39,12 @@ func TestAccEventDataStore_basic(t *testing.T) {
 					}),
 					resource.TestCheckResourceAttr(resourceName, "advanced_event_selector.0.name", "Default management events"),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "cloudtrail", regexp.MustCompile(`eventdatastore/.+`)),
+					acctest.CheckResourceAttrRFC3339(resourceName, "created_timestamp"),
 					resource.TestCheckResourceAttr(resourceName, "multi_region_enabled", "true"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "organization_enabled", "false"),
 					resource.TestCheckResourceAttr(resourceName, "retention_period", "2555"),
+					resource.TestCheckResourceAttr(resourceName, "status", cloudtrail.EventDataStoreStatusEnabled),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttr(resourceName, "termination_protection_enabled", "false"),
 				),
@@ -30,6 +30,10 @@ func ResourceLag() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"aws_device": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"connection_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -156,6 +160,7 @@ func resourceLagRead(d *schema.ResourceData, meta interface{}) error {
 		Resource:  fmt.Sprintf("dxlag/%s", d.Id()),
 	}.String()
 	d.Set("arn", arn)
+	d.Set("aws_device", lag.AwsDeviceV2)
 	d.Set("connections_bandwidth", lag.ConnectionsBandwidth)
 	d.Set("has_logical_redundancy", lag.HasLogicalRedundancy)
 	d.Set("jumbo_frame_capable", lag.JumboFrameCapable)
@@ -0,0 +1,465 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2CapacityReservationFleet_basic(t *testing.T) {
+	var fleet ec2.CapacityReservationFleet
+	availabilityZonesDataSourceName := "data.aws_availability_zones.available"
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckCapacityReservationFleet(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEc2CapacityReservationFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2CapacityReservationFleetConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2CapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "allocation_strategy", "prioritized"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`capacity-reservation-fleet/crf-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "end_date", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "fleet_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "instance_match_criteria", "open"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_specification.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_type_specification.0.availability_zone", availabilityZonesDataSourceName, "names.0"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_specification.0.instance_platform", "Linux/UNIX"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_specification.0.instance_type", "t3.micro"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_specification.0.priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_specification.0.weight", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tenancy", "default"),
+					resource.TestCheckResourceAttr(resourceName, "total_target_capacity", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityReservationFleet_disappears(t *testing.T) {
+	var fleet ec2.CapacityReservationFleet
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckCapacityReservationFleet(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEc2CapacityReservationFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2CapacityReservationFleetConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2CapacityReservationFleetExists(resourceName, &fleet),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceCapacityReservationFleet(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityReservationFleet_availabilityZoneOnly(t *testing.T) {
+	var fleet ec2.CapacityReservationFleet
+	availabilityZonesDataSourceName := "data.aws_availability_zones.available"
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckCapacityReservationFleet(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEc2CapacityReservationFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2CapacityReservationFleetConfig_availabilityZoneOnly(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2CapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_specification.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_type_specification.0.availability_zone", availabilityZonesDataSourceName, "names.0"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_type_specification.0.availability_zone_id", availabilityZonesDataSourceName, "zone_ids.0"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_type_specification.0.priority"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_type_specification.0.weight"),
+				),
+			},
+			{
+				Config:   testAccEc2CapacityReservationFleetConfig_availabilityZoneOnly(rName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityReservationFleet_multipleInstanceTypeSpecifications(t *testing.T) {
+	var fleet ec2.CapacityReservationFleet
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckCapacityReservationFleet(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEc2CapacityReservationFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2CapacityReservationFleetConfig_multipleInstanceTypeSpecifications(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2CapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_specification.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_specification.0.instance_type", "t3.small"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_specification.0.priority", "2"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_specification.1.instance_type", "t3.micro"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type_specification.1.priority", "1"),
+				),
+			},
+			{
+				Config:   testAccEc2CapacityReservationFleetConfig_multipleInstanceTypeSpecifications(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityReservationFleet_totalTargetCapacity(t *testing.T) {
+	var fleet ec2.CapacityReservationFleet
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckCapacityReservationFleet(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEc2CapacityReservationFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2CapacityReservationFleetConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2CapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "total_target_capacity", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEc2CapacityReservationFleetConfig(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2CapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "total_target_capacity", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityReservationFleet_endDate(t *testing.T) {
+	var fleet ec2.CapacityReservationFleet
+	endDate1 := time.Now().UTC().Add(12 * time.Hour).Format(time.RFC3339)
+	endDate2 := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckCapacityReservationFleet(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEc2CapacityReservationFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2CapacityReservationFleetConfig_endDate(rName, endDate1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2CapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "end_date", endDate1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEc2CapacityReservationFleetConfig_endDate(rName, endDate2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2CapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "end_date", endDate2),
+				),
+			},
+			{
+				Config: testAccEc2CapacityReservationFleetConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2CapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "end_date", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2CapacityReservationFleet_tags(t *testing.T) {
+	var fleet ec2.CapacityReservationFleet
+	resourceName := "aws_ec2_capacity_reservation_fleet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckCapacityReservationFleet(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEc2CapacityReservationFleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2CapacityReservationFleetConfig_tags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2CapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEc2CapacityReservationFleetConfig_tags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2CapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccEc2CapacityReservationFleetConfig_tags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEc2CapacityReservationFleetExists(resourceName, &fleet),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEc2CapacityReservationFleetExists(n string, v *ec2.CapacityReservationFleet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Capacity Reservation Fleet ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindCapacityReservationFleetByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckEc2CapacityReservationFleetDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_capacity_reservation_fleet" {
+			continue
+		}
+
+		_, err := tfec2.FindCapacityReservationFleetByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 Capacity Reservation Fleet %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPreCheckCapacityReservationFleet(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	input := &ec2.DescribeCapacityReservationFleetsInput{
+		MaxResults: aws.Int64(1),
+	}
+
+	_, err := conn.DescribeCapacityReservationFleets(input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccEc2CapacityReservationFleetConfig(rName string, totalTargetCapacity int) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_fleet" "test" {
+  total_target_capacity = %[2]d
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t3.micro"
+    priority          = 1
+    weight            = 1
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, totalTargetCapacity))
+}
+
+func testAccEc2CapacityReservationFleetConfig_availabilityZoneOnly(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_fleet" "test" {
+  total_target_capacity = 1
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t3.micro"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccEc2CapacityReservationFleetConfig_multipleInstanceTypeSpecifications(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_fleet" "test" {
+  total_target_capacity = 2
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t3.small"
+    priority          = 2
+    weight            = 2
+  }
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t3.micro"
+    priority          = 1
+    weight            = 1
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccEc2CapacityReservationFleetConfig_endDate(rName, endDate string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_fleet" "test" {
+  end_date              = %[2]q
+  total_target_capacity = 1
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t3.micro"
+    priority          = 1
+    weight            = 1
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, endDate))
+}
+
+func testAccEc2CapacityReservationFleetConfig_tags1(tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_fleet" "test" {
+  total_target_capacity = 1
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t3.micro"
+    priority          = 1
+    weight            = 1
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccEc2CapacityReservationFleetConfig_tags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation_fleet" "test" {
+  total_target_capacity = 1
+
+  instance_type_specification {
+    availability_zone = data.aws_availability_zones.available.names[0]
+    instance_platform = "Linux/UNIX"
+    instance_type     = "t3.micro"
+    priority          = 1
+    weight            = 1
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
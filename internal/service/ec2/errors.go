@@ -22,6 +22,7 @@ const (
 	ErrCodeInvalidAllocationIDNotFound                    = "InvalidAllocationID.NotFound"
 	ErrCodeInvalidAssociationIDNotFound                   = "InvalidAssociationID.NotFound"
 	ErrCodeInvalidAttachmentIDNotFound                    = "InvalidAttachmentID.NotFound"
+	ErrCodeInvalidCapacityReservationFleetIdNotFound      = "InvalidCapacityReservationFleetId.NotFound"
 	ErrCodeInvalidCapacityReservationIdNotFound           = "InvalidCapacityReservationId.NotFound'"
 	ErrCodeInvalidCarrierGatewayIDNotFound                = "InvalidCarrierGatewayID.NotFound"
 	ErrCodeInvalidClientVpnActiveAssociationNotFound      = "InvalidClientVpnActiveAssociationNotFound"
@@ -86,6 +87,26 @@ const (
 	ErrCodeUnsupportedOperation                           = "UnsupportedOperation"
 )
 
+func CancelCapacityReservationFleetError(apiObject *ec2.FailedCapacityReservationFleetCancellationResult) error {
+	if apiObject == nil || apiObject.CancelCapacityReservationFleetError == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.CancelCapacityReservationFleetError.Code), aws.StringValue(apiObject.CancelCapacityReservationFleetError.Message), nil)
+}
+
+func CancelCapacityReservationFleetsError(apiObjects []*ec2.FailedCapacityReservationFleetCancellationResult) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if err := CancelCapacityReservationFleetError(apiObject); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("%s: %w", aws.StringValue(apiObject.CapacityReservationFleetId), err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
+
 func CancelSpotFleetRequestError(apiObject *ec2.CancelSpotFleetRequestsErrorItem) error {
 	if apiObject == nil || apiObject.Error == nil {
 		return nil
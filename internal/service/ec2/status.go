@@ -45,6 +45,22 @@ func StatusCapacityReservationState(conn *ec2.EC2, id string) resource.StateRefr
 	}
 }
 
+func StatusCapacityReservationFleetState(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCapacityReservationFleetByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
 const (
 	carrierGatewayStateNotFound = "NotFound"
 	carrierGatewayStateUnknown  = "Unknown"
@@ -32,6 +32,11 @@ func init() {
 		F:    sweepCapacityReservations,
 	})
 
+	resource.AddTestSweepers("aws_ec2_capacity_reservation_fleet", &resource.Sweeper{
+		Name: "aws_ec2_capacity_reservation_fleet",
+		F:    sweepCapacityReservationFleets,
+	})
+
 	resource.AddTestSweepers("aws_ec2_carrier_gateway", &resource.Sweeper{
 		Name: "aws_ec2_carrier_gateway",
 		F:    sweepCarrierGateway,
@@ -406,6 +411,53 @@ func sweepCapacityReservations(region string) error {
 	return nil
 }
 
+func sweepCapacityReservationFleets(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*conns.AWSClient).EC2Conn
+	input := &ec2.DescribeCapacityReservationFleetsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.DescribeCapacityReservationFleetsPages(input, func(page *ec2.DescribeCapacityReservationFleetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CapacityReservationFleets {
+			if state := aws.StringValue(v.State); state == ec2.CapacityReservationFleetStateCancelled || state == ec2.CapacityReservationFleetStateExpired {
+				continue
+			}
+
+			r := ResourceCapacityReservationFleet()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.CapacityReservationFleetId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping EC2 Capacity Reservation Fleet sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing EC2 Capacity Reservation Fleets (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping EC2 Capacity Reservation Fleets (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepCarrierGateway(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
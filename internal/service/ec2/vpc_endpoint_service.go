@@ -327,6 +327,12 @@ func resourceVPCEndpointServiceUpdate(d *schema.ResourceData, meta interface{})
 		if err := vpcEndpointServiceWaitUntilAvailable(d, conn); err != nil {
 			return err
 		}
+
+		if v := d.Get("private_dns_name").(string); d.HasChange("private_dns_name") && v != "" {
+			if err := vpcEndpointServiceWaitForPrivateDNSNameConfiguration(conn, d.Id(), v); err != nil {
+				return err
+			}
+		}
 	}
 
 	if d.HasChange("allowed_principals") {
@@ -426,6 +432,46 @@ func vpcEndpointServiceWaitUntilAvailable(d *schema.ResourceData, conn *ec2.EC2)
 	return nil
 }
 
+// vpcEndpointServiceWaitForPrivateDNSNameConfiguration waits for the private DNS name
+// verification state to reflect the specified name. Verification itself happens out of band.
+func vpcEndpointServiceWaitForPrivateDNSNameConfiguration(conn *ec2.EC2, svcId, name string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{""},
+		Target:     []string{ec2.DnsNameStatePendingVerification, ec2.DnsNameStateVerified, ec2.DnsNameStateFailed},
+		Refresh:    vpcEndpointServicePrivateDNSNameConfigurationStateRefresh(conn, svcId, name),
+		Timeout:    10 * time.Minute,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint Service %s private DNS name (%s) configuration: %s", svcId, name, err.Error())
+	}
+
+	return nil
+}
+
+func vpcEndpointServicePrivateDNSNameConfigurationStateRefresh(conn *ec2.EC2, svcId, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeVpcEndpointServiceConfigurations(&ec2.DescribeVpcEndpointServiceConfigurationsInput{
+			ServiceIds: aws.StringSlice([]string{svcId}),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(resp.ServiceConfigurations) == 0 || resp.ServiceConfigurations[0] == nil {
+			return nil, "", nil
+		}
+
+		svcCfg := resp.ServiceConfigurations[0]
+		if v := svcCfg.PrivateDnsNameConfiguration; v != nil && aws.StringValue(svcCfg.PrivateDnsName) == name {
+			return svcCfg, aws.StringValue(v.State), nil
+		}
+
+		return svcCfg, "", nil
+	}
+}
+
 func waitForVpcEndpointServiceDeletion(conn *ec2.EC2, serviceID string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:    []string{ec2.ServiceStateAvailable, ec2.ServiceStateDeleting},
@@ -29,6 +29,10 @@ func ResourceVPCEndpointServiceAllowedPrincipal() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"principal_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -74,19 +78,21 @@ func resourceVPCEndpointServiceAllowedPrincipalRead(d *schema.ResourceData, meta
 		return err
 	}
 
-	found := false
+	var found *ec2.AllowedPrincipal
 	for _, principal := range principals {
 		if aws.StringValue(principal.Principal) == arn {
-			found = true
+			found = principal
 			break
 		}
 	}
-	if !found {
+	if found == nil {
 		log.Printf("[WARN] VPC Endpoint Service allowed principal (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	d.Set("principal_type", found.PrincipalType)
+
 	return nil
 }
 
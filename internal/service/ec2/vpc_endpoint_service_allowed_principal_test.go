@@ -27,6 +27,7 @@ func TestAccEC2VPCEndpointServiceAllowedPrincipal_basic(t *testing.T) {
 				Config: testAccVpcEndpointServiceAllowedPrincipalBasicConfig(lbName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVpcEndpointServiceAllowedPrincipalExists("aws_vpc_endpoint_service_allowed_principal.foo"),
+					resource.TestCheckResourceAttrSet("aws_vpc_endpoint_service_allowed_principal.foo", "principal_type"),
 				),
 			},
 		},
@@ -200,7 +200,7 @@ func TestAccEC2VPCEndpointService_tags(t *testing.T) {
 }
 
 func TestAccEC2VPCEndpointService_PrivateDNS_name(t *testing.T) {
-	var svcCfg ec2.ServiceConfiguration
+	var svcCfg, svcCfg1 ec2.ServiceConfiguration
 	resourceName := "aws_vpc_endpoint_service.test"
 	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -214,7 +214,7 @@ func TestAccEC2VPCEndpointService_PrivateDNS_name(t *testing.T) {
 			{
 				Config: testAccVpcEndpointServiceConfigPrivateDnsName(rName1, rName2, "example.com"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckVpcEndpointServiceExists(resourceName, &svcCfg),
+					testAccCheckVpcEndpointServiceExists(resourceName, &svcCfg1),
 					resource.TestCheckResourceAttr(resourceName, "private_dns_name", "example.com"),
 					resource.TestCheckResourceAttr(resourceName, "private_dns_name_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "private_dns_name_configuration.0.type", "TXT"),
@@ -229,6 +229,7 @@ func TestAccEC2VPCEndpointService_PrivateDNS_name(t *testing.T) {
 				Config: testAccVpcEndpointServiceConfigPrivateDnsName(rName1, rName2, "changed.example.com"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckVpcEndpointServiceExists(resourceName, &svcCfg),
+					testAccCheckVpcEndpointServiceNotRecreated(&svcCfg1, &svcCfg),
 					resource.TestCheckResourceAttr(resourceName, "private_dns_name", "changed.example.com"),
 					resource.TestCheckResourceAttr(resourceName, "private_dns_name_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "private_dns_name_configuration.0.type", "TXT"),
@@ -295,6 +296,16 @@ func testAccCheckVpcEndpointServiceExists(n string, svcCfg *ec2.ServiceConfigura
 	}
 }
 
+func testAccCheckVpcEndpointServiceNotRecreated(i, j *ec2.ServiceConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.ServiceId) != aws.StringValue(j.ServiceId) {
+			return fmt.Errorf("VPC Endpoint Service was recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccVpcEndpointServiceConfig_base(rName1, rName2 string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -106,6 +106,50 @@ func WaitCapacityReservationDeleted(conn *ec2.EC2, id string) (*ec2.CapacityRese
 	return nil, err
 }
 
+const (
+	CapacityReservationFleetActiveTimeout  = 10 * time.Minute
+	CapacityReservationFleetDeletedTimeout = 10 * time.Minute
+)
+
+func WaitCapacityReservationFleetActive(conn *ec2.EC2, id string) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationFleetStateSubmitted, ec2.CapacityReservationFleetStateModifying},
+		Target:  []string{ec2.CapacityReservationFleetStateActive, ec2.CapacityReservationFleetStatePartiallyFulfilled},
+		Refresh: StatusCapacityReservationFleetState(conn, id),
+		Timeout: CapacityReservationFleetActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitCapacityReservationFleetDeleted(conn *ec2.EC2, id string) (*ec2.CapacityReservationFleet, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.CapacityReservationFleetStateActive,
+			ec2.CapacityReservationFleetStateCancelling,
+			ec2.CapacityReservationFleetStateModifying,
+			ec2.CapacityReservationFleetStatePartiallyFulfilled,
+		},
+		Target:  []string{},
+		Refresh: StatusCapacityReservationFleetState(conn, id),
+		Timeout: CapacityReservationFleetDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*ec2.CapacityReservationFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	CarrierGatewayAvailableTimeout = 5 * time.Minute
 
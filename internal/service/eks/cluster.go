@@ -34,10 +34,17 @@ func ResourceCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
+			customizeDiffTagsCount,
 			customdiff.ForceNewIfChange("encryption_config", func(_ context.Context, old, new, meta interface{}) bool {
 				// You cannot disable envelope encryption after enabling it. This action is irreversible.
 				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
 			}),
+			customdiff.ComputedIf("encryption_enabled", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("encryption_config")
+			}),
+			customdiff.ComputedIf("vpc_config.0.publicly_accessible", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("vpc_config.0.endpoint_public_access") || diff.HasChange("vpc_config.0.public_access_cidrs")
+			}),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -106,6 +113,10 @@ func ResourceCluster() *schema.Resource {
 					},
 				},
 			},
+			"encryption_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			"endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -214,6 +225,10 @@ func ResourceCluster() *schema.Resource {
 								ValidateFunc: verify.ValidCIDRNetworkAddress,
 							},
 						},
+						"publicly_accessible": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
 						"security_group_ids": {
 							Type:     schema.TypeSet,
 							Optional: true,
@@ -354,6 +369,8 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting encryption_config: %w", err)
 	}
 
+	d.Set("encryption_enabled", len(cluster.EncryptionConfig) > 0)
+
 	d.Set("endpoint", cluster.Endpoint)
 
 	if err := d.Set("identity", flattenEksIdentity(cluster.Identity)); err != nil {
@@ -751,12 +768,28 @@ func flattenEksVpcConfigResponse(vpcConfig *eks.VpcConfigResponse) []map[string]
 		"security_group_ids":        flex.FlattenStringSet(vpcConfig.SecurityGroupIds),
 		"subnet_ids":                flex.FlattenStringSet(vpcConfig.SubnetIds),
 		"public_access_cidrs":       flex.FlattenStringSet(vpcConfig.PublicAccessCidrs),
+		"publicly_accessible":       clusterEndpointPubliclyAccessible(vpcConfig),
 		"vpc_id":                    aws.StringValue(vpcConfig.VpcId),
 	}
 
 	return []map[string]interface{}{m}
 }
 
+// clusterEndpointPubliclyAccessible returns whether the cluster's public API server endpoint is reachable from any address.
+func clusterEndpointPubliclyAccessible(vpcConfig *eks.VpcConfigResponse) bool {
+	if !aws.BoolValue(vpcConfig.EndpointPublicAccess) {
+		return false
+	}
+
+	for _, cidr := range aws.StringValueSlice(vpcConfig.PublicAccessCidrs) {
+		if cidr == "0.0.0.0/0" {
+			return true
+		}
+	}
+
+	return false
+}
+
 func flattenEksEnabledLogTypes(logging *eks.Logging) *schema.Set {
 	enabledLogTypes := []*string{}
 
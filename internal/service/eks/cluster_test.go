@@ -1,8 +1,10 @@
 package eks_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -11,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/eks"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -52,6 +55,7 @@ func TestAccEKSCluster_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.endpoint_private_access", "false"),
 					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.endpoint_public_access", "true"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.publicly_accessible", "true"),
 					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.security_group_ids.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.subnet_ids.#", "2"),
 					resource.TestMatchResourceAttr(resourceName, "vpc_config.0.vpc_id", regexp.MustCompile(`^vpc-.+`)),
@@ -109,6 +113,7 @@ func TestAccEKSCluster_Encryption_create(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "encryption_config.0.provider.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "encryption_config.0.provider.0.key_arn", kmsKeyResourceName, "arn"),
 					resource.TestCheckResourceAttr(resourceName, "encryption_config.0.resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_enabled", "true"),
 				),
 			},
 			{
@@ -137,6 +142,7 @@ func TestAccEKSCluster_Encryption_update(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckClusterExists(resourceName, &cluster1),
 					resource.TestCheckResourceAttr(resourceName, "encryption_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_enabled", "false"),
 				),
 			},
 			{
@@ -148,6 +154,7 @@ func TestAccEKSCluster_Encryption_update(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "encryption_config.0.provider.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "encryption_config.0.provider.0.key_arn", kmsKeyResourceName, "arn"),
 					resource.TestCheckResourceAttr(resourceName, "encryption_config.0.resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_enabled", "true"),
 				),
 			},
 			{
@@ -468,6 +475,7 @@ func TestAccEKSCluster_VPC_publicAccessCIDRs(t *testing.T) {
 					testAccCheckClusterExists(resourceName, &cluster),
 					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.public_access_cidrs.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.publicly_accessible", "false"),
 				),
 			},
 			{
@@ -481,6 +489,16 @@ func TestAccEKSCluster_VPC_publicAccessCIDRs(t *testing.T) {
 					testAccCheckClusterExists(resourceName, &cluster),
 					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.public_access_cidrs.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.publicly_accessible", "false"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_VPCConfig_PublicAccessCIDRs(rName, `["0.0.0.0/0"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.public_access_cidrs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.publicly_accessible", "true"),
 				),
 			},
 		},
@@ -599,6 +617,57 @@ func TestAccEKSCluster_Network_ipFamily(t *testing.T) {
 	})
 }
 
+func TestClusterPlatformVersionSchema(t *testing.T) {
+	actualSchema := tfeks.ResourceCluster().Schema["platform_version"]
+	expectedSchema := &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	if !reflect.DeepEqual(actualSchema, expectedSchema) {
+		t.Fatalf(
+			"Got:\n\n%#v\n\nExpected:\n\n%#v\n",
+			actualSchema,
+			expectedSchema)
+	}
+}
+
+func TestClusterPlatformVersionDiff(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":     "test",
+		"role_arn": "arn:aws:iam::123456789012:role/test", // lintignore:AWSAT005
+		"vpc_config": []interface{}{
+			map[string]interface{}{
+				"subnet_ids": []interface{}{"subnet-12345678"},
+			},
+		},
+	})
+
+	// The platform version is patched by AWS between refreshes.
+	for _, platformVersion := range []string{"eks.1", "eks.2"} {
+		state := &terraform.InstanceState{
+			ID: "test",
+			Attributes: map[string]string{
+				"id":               "test",
+				"platform_version": platformVersion,
+			},
+		}
+
+		diff, err := schema.InternalMap(tfeks.ResourceCluster().Schema).Diff(context.Background(), state, config, nil, nil, true)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if diff == nil {
+			continue
+		}
+
+		if _, ok := diff.Attributes["platform_version"]; ok {
+			t.Errorf("platform_version %q: unexpected diff: %#v", platformVersion, diff.Attributes["platform_version"])
+		}
+	}
+}
+
 func testAccCheckClusterExists(resourceName string, cluster *eks.Cluster) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -0,0 +1,139 @@
+package eks
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+)
+
+func TestFlattenEksEnabledLogTypes(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *eks.Logging
+		Expected []string
+	}{
+		{
+			Name:     "nil",
+			Input:    nil,
+			Expected: []string{},
+		},
+		{
+			Name: "all disabled",
+			Input: &eks.Logging{
+				ClusterLogging: []*eks.LogSetup{
+					{
+						Enabled: aws.Bool(false),
+						Types:   aws.StringSlice([]string{eks.LogTypeApi, eks.LogTypeAudit}),
+					},
+				},
+			},
+			Expected: []string{},
+		},
+		{
+			Name: "some enabled",
+			Input: &eks.Logging{
+				ClusterLogging: []*eks.LogSetup{
+					nil,
+					{
+						Enabled: aws.Bool(true),
+						Types:   aws.StringSlice([]string{eks.LogTypeApi, eks.LogTypeAudit}),
+					},
+					{
+						Enabled: aws.Bool(false),
+						Types:   aws.StringSlice([]string{eks.LogTypeScheduler}),
+					},
+				},
+			},
+			Expected: []string{eks.LogTypeApi, eks.LogTypeAudit},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := flattenEksEnabledLogTypes(testCase.Input)
+
+			if got == nil {
+				t.Fatal("expected empty set, got nil")
+			}
+
+			var actual []string
+			for _, v := range got.List() {
+				actual = append(actual, v.(string))
+			}
+			if actual == nil {
+				actual = []string{}
+			}
+			sort.Strings(actual)
+
+			if !reflect.DeepEqual(actual, testCase.Expected) {
+				t.Errorf("got %v, expected %v", actual, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestClusterEndpointPubliclyAccessible(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *eks.VpcConfigResponse
+		Expected bool
+	}{
+		{
+			Name:     "empty",
+			Input:    &eks.VpcConfigResponse{},
+			Expected: false,
+		},
+		{
+			Name: "public access disabled with open CIDR",
+			Input: &eks.VpcConfigResponse{
+				EndpointPublicAccess: aws.Bool(false),
+				PublicAccessCidrs:    aws.StringSlice([]string{"0.0.0.0/0"}),
+			},
+			Expected: false,
+		},
+		{
+			Name: "public access enabled with open CIDR",
+			Input: &eks.VpcConfigResponse{
+				EndpointPublicAccess: aws.Bool(true),
+				PublicAccessCidrs:    aws.StringSlice([]string{"0.0.0.0/0"}),
+			},
+			Expected: true,
+		},
+		{
+			Name: "public access enabled with open CIDR among others",
+			Input: &eks.VpcConfigResponse{
+				EndpointPublicAccess: aws.Bool(true),
+				PublicAccessCidrs:    aws.StringSlice([]string{"10.0.0.0/8", "0.0.0.0/0"}),
+			},
+			Expected: true,
+		},
+		{
+			Name: "public access enabled with restricted CIDRs",
+			Input: &eks.VpcConfigResponse{
+				EndpointPublicAccess: aws.Bool(true),
+				PublicAccessCidrs:    aws.StringSlice([]string{"1.2.3.4/32", "5.6.7.0/24"}),
+			},
+			Expected: false,
+		},
+		{
+			Name: "public access enabled without CIDRs",
+			Input: &eks.VpcConfigResponse{
+				EndpointPublicAccess: aws.Bool(true),
+			},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := clusterEndpointPubliclyAccessible(testCase.Input)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -1,10 +1,18 @@
 package eks
 
 import (
+	"context"
 	"fmt"
 	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
+// https://docs.aws.amazon.com/eks/latest/userguide/eks-using-tags.html#tag-restrictions.
+const maxTagsPerResource = 50
+
 func validClusterName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if len(value) < 1 || len(value) > 100 {
@@ -22,3 +30,21 @@ func validClusterName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+// customizeDiffTagsCount returns an error if the resource tags merged on to
+// those defined at the provider-level exceed the number of tags EKS allows
+// on a single resource.
+func customizeDiffTagsCount(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tags") {
+		return nil
+	}
+
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	allTags := defaultTagsConfig.MergeTags(tftags.New(diff.Get("tags").(map[string]interface{})))
+
+	if n := len(allTags); n > maxTagsPerResource {
+		return fmt.Errorf("too many tags (%d), including those in the \"default_tags\" configuration block of the provider: EKS supports a maximum of %d tags per resource", n, maxTagsPerResource)
+	}
+
+	return nil
+}
@@ -1,9 +1,15 @@
 package eks
 
 import (
+	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 func TestValidClusterName(t *testing.T) {
@@ -57,3 +63,79 @@ func TestValidClusterName(t *testing.T) {
 		}
 	}
 }
+
+func TestCustomizeDiffTagsCount(t *testing.T) {
+	tagsMap := func(prefix string, n int) map[string]interface{} {
+		m := make(map[string]interface{}, n)
+		for i := 0; i < n; i++ {
+			m[fmt.Sprintf("%s%d", prefix, i)] = "value"
+		}
+		return m
+	}
+
+	testCases := []struct {
+		Name          string
+		DefaultTags   map[string]interface{}
+		Tags          map[string]interface{}
+		ExpectedError string
+	}{
+		{
+			Name: "at limit",
+			Tags: tagsMap("resource", 50),
+		},
+		{
+			Name:          "over limit",
+			Tags:          tagsMap("resource", 51),
+			ExpectedError: "too many tags (51)",
+		},
+		{
+			Name:          "over limit with default tags",
+			DefaultTags:   tagsMap("default", 26),
+			Tags:          tagsMap("resource", 25),
+			ExpectedError: "too many tags (51)",
+		},
+		{
+			Name:        "default tags overridden by resource tags",
+			DefaultTags: tagsMap("key", 26),
+			Tags:        tagsMap("key", 25),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			meta := &conns.AWSClient{
+				DefaultTagsConfig: &tftags.DefaultConfig{
+					Tags: tftags.New(testCase.DefaultTags),
+				},
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name":     "test",
+				"role_arn": "arn:aws:iam::123456789012:role/test", // lintignore:AWSAT005
+				"tags":     testCase.Tags,
+				"vpc_config": []interface{}{
+					map[string]interface{}{
+						"subnet_ids": []interface{}{"subnet-12345678", "subnet-87654321"},
+					},
+				},
+			})
+
+			_, err := ResourceCluster().SimpleDiff(context.Background(), nil, config, meta)
+
+			if testCase.ExpectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q, got none", testCase.ExpectedError)
+			}
+
+			if !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Errorf("expected error containing %q, got %q", testCase.ExpectedError, err)
+			}
+		})
+	}
+}
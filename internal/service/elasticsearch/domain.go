@@ -367,7 +367,7 @@ func ResourceDomain() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Computed:     true,
-							ValidateFunc: validation.StringInSlice(elasticsearch.TLSSecurityPolicy_Values(), false),
+							ValidateFunc: validation.StringInSlice(TLSSecurityPolicy_Values(), false),
 						},
 					},
 				},
@@ -87,6 +87,13 @@ func TestAccElasticsearchDomain_requireHTTPS(t *testing.T) {
 					testAccCheckDomainEndpointOptions(true, "Policy-Min-TLS-1-2-2019-07", &domain),
 				),
 			},
+			{
+				Config: testAccDomainConfig_DomainEndpointOptions(rName, true, "Policy-Min-TLS-1-2-PFS-2023-10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists("aws_elasticsearch_domain.test", &domain),
+					testAccCheckDomainEndpointOptions(true, "Policy-Min-TLS-1-2-PFS-2023-10", &domain),
+				),
+			},
 		},
 	})
 }
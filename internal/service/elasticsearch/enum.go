@@ -0,0 +1,14 @@
+package elasticsearch
+
+import (
+	"github.com/aws/aws-sdk-go/service/elasticsearch"
+)
+
+const (
+	// Not yet defined in the AWS SDK for Go.
+	TLSSecurityPolicyPolicyMinTLS12PFS202310 = "Policy-Min-TLS-1-2-PFS-2023-10"
+)
+
+func TLSSecurityPolicy_Values() []string {
+	return append(elasticsearch.TLSSecurityPolicy_Values(), TLSSecurityPolicyPolicyMinTLS12PFS202310)
+}
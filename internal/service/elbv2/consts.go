@@ -0,0 +1,33 @@
+package elbv2
+
+const (
+	dnsRecordClientRoutingPolicyAnyAvailabilityZone             = "any_availability_zone"
+	dnsRecordClientRoutingPolicyAvailabilityZoneAffinity        = "availability_zone_affinity"
+	dnsRecordClientRoutingPolicyPartialAvailabilityZoneAffinity = "partial_availability_zone_affinity"
+)
+
+func dnsRecordClientRoutingPolicy_Values() []string {
+	return []string{
+		dnsRecordClientRoutingPolicyAnyAvailabilityZone,
+		dnsRecordClientRoutingPolicyAvailabilityZoneAffinity,
+		dnsRecordClientRoutingPolicyPartialAvailabilityZoneAffinity,
+	}
+}
+
+const (
+	targetGroupHealthOff = "off"
+)
+
+const (
+	targetGroupLoadBalancingCrossZoneEnabledFalse                        = "false"
+	targetGroupLoadBalancingCrossZoneEnabledTrue                         = "true"
+	targetGroupLoadBalancingCrossZoneEnabledUseLoadBalancerConfiguration = "use_load_balancer_configuration"
+)
+
+func targetGroupLoadBalancingCrossZoneEnabled_Values() []string {
+	return []string{
+		targetGroupLoadBalancingCrossZoneEnabledFalse,
+		targetGroupLoadBalancingCrossZoneEnabledTrue,
+		targetGroupLoadBalancingCrossZoneEnabledUseLoadBalancerConfiguration,
+	}
+}
@@ -0,0 +1,13 @@
+package elbv2
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// flattenLoadBalancerDNSName returns the load balancer DNS name without any
+// trailing dot so that it can be used directly in Route 53 alias records.
+func flattenLoadBalancerDNSName(dnsName *string) string {
+	return strings.TrimSuffix(aws.StringValue(dnsName), ".")
+}
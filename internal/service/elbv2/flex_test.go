@@ -0,0 +1,39 @@
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+func TestFlattenLoadBalancerDNSName(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *string
+		Expected string
+	}{
+		{
+			Name:     "nil",
+			Input:    nil,
+			Expected: "",
+		},
+		{
+			Name:     "no trailing dot",
+			Input:    aws.String("tf-lb-123456789.us-west-2.elb.amazonaws.com"),
+			Expected: "tf-lb-123456789.us-west-2.elb.amazonaws.com",
+		},
+		{
+			Name:     "trailing dot",
+			Input:    aws.String("tf-lb-123456789.us-west-2.elb.amazonaws.com."),
+			Expected: "tf-lb-123456789.us-west-2.elb.amazonaws.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := flattenLoadBalancerDNSName(testCase.Input); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
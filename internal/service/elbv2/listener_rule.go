@@ -58,7 +58,6 @@ func ResourceListenerRule() *schema.Resource {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Computed:     true,
-				ForceNew:     true,
 				ValidateFunc: validListenerRulePriority,
 			},
 			"action": {
@@ -805,7 +804,7 @@ func resourceListenerRuleUpdate(d *schema.ResourceData, meta interface{}) error
 
 		_, err := conn.SetRulePriorities(params)
 		if err != nil {
-			return err
+			return fmt.Errorf("error setting LB Listener Rule (%s) priority: %w", d.Id(), err)
 		}
 	}
 
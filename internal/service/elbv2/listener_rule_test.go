@@ -108,7 +108,7 @@ func TestAccELBV2ListenerRule_basic(t *testing.T) {
 }
 
 func TestAccELBV2ListenerRule_tags(t *testing.T) {
-	var conf elbv2.Rule
+	var before, after elbv2.Rule
 	lbName := fmt.Sprintf("testrule-basic-%s", sdkacctest.RandString(13))
 	targetGroupName := fmt.Sprintf("testtargetgroup-%s", sdkacctest.RandString(10))
 
@@ -123,7 +123,7 @@ func TestAccELBV2ListenerRule_tags(t *testing.T) {
 			{
 				Config: testAccListenerRuleTags1Config(lbName, targetGroupName, "key1", "value1"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckListenerRuleExists(resourceName, &conf),
+					testAccCheckListenerRuleExists(resourceName, &before),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
 				),
@@ -131,7 +131,8 @@ func TestAccELBV2ListenerRule_tags(t *testing.T) {
 			{
 				Config: testAccListenerRuleTags2Config(lbName, targetGroupName, "key1", "value1updated", "key2", "value2"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckListenerRuleExists(resourceName, &conf),
+					testAccCheckListenerRuleExists(resourceName, &after),
+					testAccCheckListenerRuleNotRecreated(t, &before, &after),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
@@ -140,7 +141,8 @@ func TestAccELBV2ListenerRule_tags(t *testing.T) {
 			{
 				Config: testAccListenerRuleTags1Config(lbName, targetGroupName, "key2", "value2"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckListenerRuleExists(resourceName, &conf),
+					testAccCheckListenerRuleExists(resourceName, &after),
+					testAccCheckListenerRuleNotRecreated(t, &before, &after),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
 				),
@@ -150,7 +152,7 @@ func TestAccELBV2ListenerRule_tags(t *testing.T) {
 }
 
 func TestAccELBV2ListenerRule_forwardWeighted(t *testing.T) {
-	var conf elbv2.Rule
+	var conf, confUpdated elbv2.Rule
 	lbName := fmt.Sprintf("testrule-weighted-%s", sdkacctest.RandString(13))
 	targetGroupName1 := fmt.Sprintf("testtargetgroup-%s", sdkacctest.RandString(10))
 	targetGroupName2 := fmt.Sprintf("testtargetgroup-%s", sdkacctest.RandString(10))
@@ -189,7 +191,8 @@ func TestAccELBV2ListenerRule_forwardWeighted(t *testing.T) {
 			{
 				Config: testAccListenerRuleConfig_changeForwardWeightedStickiness(lbName, targetGroupName1, targetGroupName2),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckListenerRuleExists(resourceName, &conf),
+					testAccCheckListenerRuleExists(resourceName, &confUpdated),
+					testAccCheckListenerRuleNotRecreated(t, &conf, &confUpdated),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "elasticloadbalancing", regexp.MustCompile(fmt.Sprintf(`listener-rule/app/%s/.+$`, lbName))),
 					resource.TestCheckResourceAttrPair(resourceName, "listener_arn", frontEndListenerResourceName, "arn"),
 					resource.TestCheckResourceAttr(resourceName, "priority", "100"),
@@ -435,7 +438,7 @@ func TestAccELBV2ListenerRule_updateFixedResponse(t *testing.T) {
 }
 
 func TestAccELBV2ListenerRule_updateRulePriority(t *testing.T) {
-	var rule elbv2.Rule
+	var rule, ruleUpdated elbv2.Rule
 	lbName := fmt.Sprintf("testrule-basic-%s", sdkacctest.RandString(13))
 	targetGroupName := fmt.Sprintf("testtargetgroup-%s", sdkacctest.RandString(10))
 
@@ -457,7 +460,8 @@ func TestAccELBV2ListenerRule_updateRulePriority(t *testing.T) {
 			{
 				Config: testAccListenerRuleConfig_updateRulePriority(lbName, targetGroupName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckListenerRuleExists(resourceName, &rule),
+					testAccCheckListenerRuleExists(resourceName, &ruleUpdated),
+					testAccCheckListenerRuleNotRecreated(t, &rule, &ruleUpdated),
 					resource.TestCheckResourceAttr(resourceName, "priority", "101"),
 				),
 			},
@@ -1330,6 +1334,16 @@ func testAccCheckListenerRuleRecreated(t *testing.T,
 	}
 }
 
+func testAccCheckListenerRuleNotRecreated(t *testing.T,
+	before, after *elbv2.Rule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.RuleArn), aws.StringValue(after.RuleArn); before != after {
+			t.Fatalf("Expected Listener Rule ARNs to be the same, but got %s and %s", before, after)
+		}
+		return nil
+	}
+}
+
 func testAccCheckListenerRuleExists(n string, res *elbv2.Rule) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
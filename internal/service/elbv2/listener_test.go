@@ -55,7 +55,7 @@ func TestAccELBV2Listener_basic(t *testing.T) {
 }
 
 func TestAccELBV2Listener_tags(t *testing.T) {
-	var conf elbv2.Listener
+	var before, after elbv2.Listener
 	resourceName := "aws_lb_listener.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -68,7 +68,7 @@ func TestAccELBV2Listener_tags(t *testing.T) {
 			{
 				Config: testAccListenerTags1Config(rName, "key1", "value1"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckListenerExists(resourceName, &conf),
+					testAccCheckListenerExists(resourceName, &before),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
 				),
@@ -81,7 +81,8 @@ func TestAccELBV2Listener_tags(t *testing.T) {
 			{
 				Config: testAccListenerTags2Config(rName, "key1", "value1updated", "key2", "value2"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckListenerExists(resourceName, &conf),
+					testAccCheckListenerExists(resourceName, &after),
+					testAccCheckListenerNotRecreated(t, &before, &after),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
@@ -90,7 +91,8 @@ func TestAccELBV2Listener_tags(t *testing.T) {
 			{
 				Config: testAccListenerTags1Config(rName, "key2", "value2"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckListenerExists(resourceName, &conf),
+					testAccCheckListenerExists(resourceName, &after),
+					testAccCheckListenerNotRecreated(t, &before, &after),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
 				),
@@ -624,6 +626,16 @@ func testAccCheckListenerDefaultActionOrderDisappears(listener *elbv2.Listener,
 	}
 }
 
+func testAccCheckListenerNotRecreated(t *testing.T,
+	before, after *elbv2.Listener) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.ListenerArn), aws.StringValue(after.ListenerArn); before != after {
+			t.Fatalf("Expected Listener ARNs to be the same, but got %s and %s", before, after)
+		}
+		return nil
+	}
+}
+
 func testAccCheckListenerExists(n string, res *elbv2.Listener) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
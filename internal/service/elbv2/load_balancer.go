@@ -36,6 +36,7 @@ func ResourceLoadBalancer() *schema.Resource {
 		// Subnets are ForceNew for Network Load Balancers
 		CustomizeDiff: customdiff.Sequence(
 			customizeDiffNLBSubnets,
+			customizeDiffLoadBalancerTypeAttributes,
 			verify.SetTagsDiff,
 		),
 		Importer: &schema.ResourceImporter{
@@ -186,6 +187,50 @@ func ResourceLoadBalancer() *schema.Resource {
 				},
 			},
 
+			"client_keep_alive": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(60, 604800),
+			},
+
+			"connection_logs": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return !d.Get("connection_logs.0.enabled").(bool)
+							},
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return !d.Get("connection_logs.0.enabled").(bool)
+							},
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"dns_record_client_routing_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(dnsRecordClientRoutingPolicy_Values(), false),
+			},
+
 			"enable_deletion_protection": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -227,6 +272,12 @@ func ResourceLoadBalancer() *schema.Resource {
 				DiffSuppressFunc: suppressIfLBType(elbv2.LoadBalancerTypeEnumNetwork),
 			},
 
+			"enable_zonal_shift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"ip_address_type": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -469,6 +520,45 @@ func resourceLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error
 
 	switch d.Get("load_balancer_type").(string) {
 	case elbv2.LoadBalancerTypeEnumApplication:
+		if d.HasChange("connection_logs") {
+			logs := d.Get("connection_logs").([]interface{})
+
+			if len(logs) == 1 && logs[0] != nil {
+				log := logs[0].(map[string]interface{})
+
+				enabled := log["enabled"].(bool)
+
+				attributes = append(attributes,
+					&elbv2.LoadBalancerAttribute{
+						Key:   aws.String("connection_logs.s3.enabled"),
+						Value: aws.String(strconv.FormatBool(enabled)),
+					})
+				if enabled {
+					attributes = append(attributes,
+						&elbv2.LoadBalancerAttribute{
+							Key:   aws.String("connection_logs.s3.bucket"),
+							Value: aws.String(log["bucket"].(string)),
+						},
+						&elbv2.LoadBalancerAttribute{
+							Key:   aws.String("connection_logs.s3.prefix"),
+							Value: aws.String(log["prefix"].(string)),
+						})
+				}
+			} else {
+				attributes = append(attributes, &elbv2.LoadBalancerAttribute{
+					Key:   aws.String("connection_logs.s3.enabled"),
+					Value: aws.String("false"),
+				})
+			}
+		}
+
+		if d.HasChange("client_keep_alive") {
+			attributes = append(attributes, &elbv2.LoadBalancerAttribute{
+				Key:   aws.String("client_keep_alive.seconds"),
+				Value: aws.String(strconv.Itoa(d.Get("client_keep_alive").(int))),
+			})
+		}
+
 		if d.HasChange("idle_timeout") || d.IsNewResource() {
 			attributes = append(attributes, &elbv2.LoadBalancerAttribute{
 				Key:   aws.String("idle_timeout.timeout_seconds"),
@@ -516,6 +606,25 @@ func resourceLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error
 				Value: aws.String(fmt.Sprintf("%t", d.Get("enable_cross_zone_load_balancing").(bool))),
 			})
 		}
+
+		if d.HasChange("dns_record_client_routing_policy") {
+			attributes = append(attributes, &elbv2.LoadBalancerAttribute{
+				Key:   aws.String("dns_record.client_routing_policy"),
+				Value: aws.String(d.Get("dns_record_client_routing_policy").(string)),
+			})
+		}
+	}
+
+	// Zonal shift is supported by Application and Network Load Balancers only.
+	// Like "waf.fail_open.enabled", the attribute is only sent when changed so that
+	// regions that don't recognize the key are unaffected by the default.
+	if lbType := d.Get("load_balancer_type").(string); lbType == elbv2.LoadBalancerTypeEnumApplication || lbType == elbv2.LoadBalancerTypeEnumNetwork {
+		if d.HasChange("enable_zonal_shift") {
+			attributes = append(attributes, &elbv2.LoadBalancerAttribute{
+				Key:   aws.String("zonal_shift.config.enabled"),
+				Value: aws.String(strconv.FormatBool(d.Get("enable_zonal_shift").(bool))),
+			})
+		}
 	}
 
 	if d.HasChange("enable_deletion_protection") || d.IsNewResource() {
@@ -840,7 +949,7 @@ func flattenResource(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBal
 	d.Set("security_groups", flex.FlattenStringList(lb.SecurityGroups))
 	d.Set("vpc_id", lb.VpcId)
 	d.Set("zone_id", lb.CanonicalHostedZoneId)
-	d.Set("dns_name", lb.DNSName)
+	d.Set("dns_name", flattenLoadBalancerDNSName(lb.DNSName))
 	d.Set("ip_address_type", lb.IpAddressType)
 	d.Set("load_balancer_type", lb.Type)
 	d.Set("customer_owned_ipv4_pool", lb.CustomerOwnedIpv4Pool)
@@ -865,6 +974,11 @@ func flattenResource(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBal
 		"enabled": false,
 		"prefix":  "",
 	}
+	connectionLogMap := map[string]interface{}{
+		"bucket":  "",
+		"enabled": false,
+		"prefix":  "",
+	}
 
 	for _, attr := range attributesResp.Attributes {
 		switch aws.StringValue(attr.Key) {
@@ -874,6 +988,27 @@ func flattenResource(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBal
 			accessLogMap["bucket"] = aws.StringValue(attr.Value)
 		case "access_logs.s3.prefix":
 			accessLogMap["prefix"] = aws.StringValue(attr.Value)
+		case "connection_logs.s3.enabled":
+			connectionLogMap["enabled"] = aws.StringValue(attr.Value) == "true"
+		case "connection_logs.s3.bucket":
+			connectionLogMap["bucket"] = aws.StringValue(attr.Value)
+		case "connection_logs.s3.prefix":
+			connectionLogMap["prefix"] = aws.StringValue(attr.Value)
+		case "client_keep_alive.seconds":
+			clientKeepAlive, err := strconv.Atoi(aws.StringValue(attr.Value))
+			if err != nil {
+				return fmt.Errorf("error parsing ALB client keep alive: %w", err)
+			}
+			log.Printf("[DEBUG] Setting ALB Client Keep Alive Seconds: %d", clientKeepAlive)
+			d.Set("client_keep_alive", clientKeepAlive)
+		case "dns_record.client_routing_policy":
+			dnsRecordClientRoutingPolicy := aws.StringValue(attr.Value)
+			log.Printf("[DEBUG] Setting NLB DNS Record Client Routing Policy: %s", dnsRecordClientRoutingPolicy)
+			d.Set("dns_record_client_routing_policy", dnsRecordClientRoutingPolicy)
+		case "zonal_shift.config.enabled":
+			zonalShiftEnabled := aws.StringValue(attr.Value) == "true"
+			log.Printf("[DEBUG] Setting LB Zonal Shift Enabled: %t", zonalShiftEnabled)
+			d.Set("enable_zonal_shift", zonalShiftEnabled)
 		case "idle_timeout.timeout_seconds":
 			timeout, err := strconv.Atoi(aws.StringValue(attr.Value))
 			if err != nil {
@@ -912,6 +1047,14 @@ func flattenResource(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBal
 		return fmt.Errorf("error setting access_logs: %w", err)
 	}
 
+	if aws.StringValue(lb.Type) == elbv2.LoadBalancerTypeEnumApplication {
+		if err := d.Set("connection_logs", []interface{}{connectionLogMap}); err != nil {
+			return fmt.Errorf("error setting connection_logs: %w", err)
+		}
+	} else {
+		d.Set("connection_logs", nil)
+	}
+
 	tags, err := ListTags(conn, d.Id())
 
 	if verify.CheckISOErrorTagsUnsupported(err) {
@@ -937,6 +1080,47 @@ func flattenResource(d *schema.ResourceData, meta interface{}, lb *elbv2.LoadBal
 	return nil
 }
 
+// Some load balancer attributes are only supported by a single load balancer
+// type. Reject them at plan time rather than letting ModifyLoadBalancerAttributes
+// fail part way through an apply.
+func customizeDiffLoadBalancerTypeAttributes(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.NewValueKnown("load_balancer_type") {
+		return nil
+	}
+
+	lbType := diff.Get("load_balancer_type").(string)
+
+	// client_keep_alive and dns_record_client_routing_policy are Computed, so the planned value
+	// may come from prior state (e.g. when load_balancer_type changes). Only check the configuration.
+	rawConfig := diff.GetRawConfig()
+
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	if lbType != elbv2.LoadBalancerTypeEnumApplication {
+		if v := rawConfig.GetAttr("connection_logs"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+			return fmt.Errorf("connection_logs is only supported for load balancers of type %q", elbv2.LoadBalancerTypeEnumApplication)
+		}
+
+		if v := rawConfig.GetAttr("client_keep_alive"); !v.IsNull() {
+			return fmt.Errorf("client_keep_alive is only supported for load balancers of type %q", elbv2.LoadBalancerTypeEnumApplication)
+		}
+	}
+
+	if lbType != elbv2.LoadBalancerTypeEnumNetwork {
+		if v := rawConfig.GetAttr("dns_record_client_routing_policy"); !v.IsNull() {
+			return fmt.Errorf("dns_record_client_routing_policy is only supported for load balancers of type %q", elbv2.LoadBalancerTypeEnumNetwork)
+		}
+	}
+
+	if lbType == elbv2.LoadBalancerTypeEnumGateway && diff.Get("enable_zonal_shift").(bool) {
+		return fmt.Errorf("enable_zonal_shift is not supported for load balancers of type %q", elbv2.LoadBalancerTypeEnumGateway)
+	}
+
+	return nil
+}
+
 // Load balancers of type 'network' cannot have their subnets updated at
 // this time. If the type is 'network' and subnets have changed, mark the
 // diff as a ForceNew operation
@@ -239,7 +239,7 @@ func dataSourceLoadBalancerRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("security_groups", flex.FlattenStringList(lb.SecurityGroups))
 	d.Set("vpc_id", lb.VpcId)
 	d.Set("zone_id", lb.CanonicalHostedZoneId)
-	d.Set("dns_name", lb.DNSName)
+	d.Set("dns_name", flattenLoadBalancerDNSName(lb.DNSName))
 	d.Set("ip_address_type", lb.IpAddressType)
 	d.Set("load_balancer_type", lb.Type)
 	d.Set("customer_owned_ipv4_pool", lb.CustomerOwnedIpv4Pool)
@@ -138,6 +138,12 @@ func ResourceTargetGroup() *schema.Resource {
 					"least_outstanding_requests",
 				}, false),
 			},
+			"load_balancing_cross_zone_enabled": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(targetGroupLoadBalancingCrossZoneEnabled_Values(), false),
+			},
 			"name": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -256,6 +262,80 @@ func ResourceTargetGroup() *schema.Resource {
 					},
 				},
 			},
+			"target_group_health": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_failover": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"minimum_healthy_targets_count": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "1",
+										ValidateFunc: validTargetGroupHealthMinimumHealthyTargetsCount,
+									},
+									"minimum_healthy_targets_percentage": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      targetGroupHealthOff,
+										ValidateFunc: validTargetGroupHealthMinimumHealthyTargetsPercentage,
+									},
+								},
+							},
+						},
+						"unhealthy_state_routing": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"minimum_healthy_targets_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"minimum_healthy_targets_percentage": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      targetGroupHealthOff,
+										ValidateFunc: validTargetGroupHealthMinimumHealthyTargetsPercentage,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"target_health_state": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_unhealthy_connection_termination": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"unhealthy_draining_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 360000),
+						},
+					},
+				},
+			},
 			"target_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -426,6 +506,21 @@ func resourceTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
 			})
 		}
 
+		if v, ok := d.GetOk("load_balancing_cross_zone_enabled"); ok {
+			attrs = append(attrs, &elbv2.TargetGroupAttribute{
+				Key:   aws.String("load_balancing.cross_zone.enabled"),
+				Value: aws.String(v.(string)),
+			})
+		}
+
+		if v, ok := d.GetOk("target_group_health"); ok && len(v.([]interface{})) > 0 {
+			attrs = append(attrs, expandTargetGroupHealthAttributes(v.([]interface{}))...)
+		}
+
+		if v, ok := d.GetOk("target_health_state"); ok && len(v.([]interface{})) > 0 {
+			attrs = append(attrs, expandTargetHealthStateAttributes(v.([]interface{}))...)
+		}
+
 		if v, ok := d.GetOk("preserve_client_ip"); ok {
 			attrs = append(attrs, &elbv2.TargetGroupAttribute{
 				Key:   aws.String("preserve_client_ip.enabled"),
@@ -734,6 +829,21 @@ func resourceTargetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 				Value: aws.String(d.Get("load_balancing_algorithm_type").(string)),
 			})
 		}
+
+		if d.HasChange("load_balancing_cross_zone_enabled") {
+			attrs = append(attrs, &elbv2.TargetGroupAttribute{
+				Key:   aws.String("load_balancing.cross_zone.enabled"),
+				Value: aws.String(d.Get("load_balancing_cross_zone_enabled").(string)),
+			})
+		}
+
+		if d.HasChange("target_group_health") {
+			attrs = append(attrs, expandTargetGroupHealthAttributes(d.Get("target_group_health").([]interface{}))...)
+		}
+
+		if d.HasChange("target_health_state") {
+			attrs = append(attrs, expandTargetHealthStateAttributes(d.Get("target_health_state").([]interface{}))...)
+		}
 	case elbv2.TargetTypeEnumLambda:
 		if d.HasChange("lambda_multi_value_headers_enabled") {
 			attrs = append(attrs, &elbv2.TargetGroupAttribute{
@@ -947,6 +1057,8 @@ func flattenTargetGroupResource(d *schema.ResourceData, meta interface{}, target
 		case "load_balancing.algorithm.type":
 			loadBalancingAlgorithm := aws.StringValue(attr.Value)
 			d.Set("load_balancing_algorithm_type", loadBalancingAlgorithm)
+		case "load_balancing.cross_zone.enabled":
+			d.Set("load_balancing_cross_zone_enabled", attr.Value)
 		case "preserve_client_ip.enabled":
 			_, err := strconv.ParseBool(aws.StringValue(attr.Value))
 			if err != nil {
@@ -965,6 +1077,30 @@ func flattenTargetGroupResource(d *schema.ResourceData, meta interface{}, target
 		return fmt.Errorf("error setting stickiness: %w", err)
 	}
 
+	// Only set the health attributes when the API returns them, as they are not
+	// available for every target type, protocol or region.
+	targetGroupHealth, err := flattenTargetGroupHealthAttributes(attrResp.Attributes)
+	if err != nil {
+		return fmt.Errorf("error flattening target_group_health: %w", err)
+	}
+
+	if targetGroupHealth != nil {
+		if err := d.Set("target_group_health", targetGroupHealth); err != nil {
+			return fmt.Errorf("error setting target_group_health: %w", err)
+		}
+	}
+
+	targetHealthState, err := flattenTargetHealthStateAttributes(attrResp.Attributes)
+	if err != nil {
+		return fmt.Errorf("error flattening target_health_state: %w", err)
+	}
+
+	if targetHealthState != nil {
+		if err := d.Set("target_health_state", targetHealthState); err != nil {
+			return fmt.Errorf("error setting target_health_state: %w", err)
+		}
+	}
+
 	tags, err := ListTags(conn, d.Id())
 
 	if verify.CheckISOErrorTagsUnsupported(err) {
@@ -1034,6 +1170,23 @@ func flattenTargetGroupStickiness(attributes []*elbv2.TargetGroupAttribute) ([]i
 func resourceTargetGroupCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 	protocol := diff.Get("protocol").(string)
 
+	// Unhealthy target connection draining is only supported by Network Load Balancer target groups
+	if targetHealthStates := diff.Get("target_health_state").([]interface{}); len(targetHealthStates) == 1 && diff.HasChange("target_health_state") {
+		if protocol != elbv2.ProtocolEnumTcp && protocol != elbv2.ProtocolEnumTls {
+			return fmt.Errorf("%s: target_health_state is only supported for target groups with TCP or TLS protocol", diff.Id())
+		}
+	}
+
+	if diff.Get("target_type").(string) == elbv2.TargetTypeEnumLambda {
+		if v, ok := diff.GetOk("load_balancing_cross_zone_enabled"); ok && v.(string) != "" {
+			return fmt.Errorf("%s: load_balancing_cross_zone_enabled is not supported for target groups with target type lambda", diff.Id())
+		}
+
+		if v := diff.Get("target_group_health").([]interface{}); len(v) > 0 {
+			return fmt.Errorf("%s: target_group_health is not supported for target groups with target type lambda", diff.Id())
+		}
+	}
+
 	// Network Load Balancers have many special quirks to them.
 	// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_CreateTargetGroup.html
 	if healthChecks := diff.Get("health_check").([]interface{}); len(healthChecks) == 1 {
@@ -1127,3 +1280,163 @@ func flattenLbTargetGroupHealthCheck(targetGroup *elbv2.TargetGroup) []interface
 
 	return []interface{}{m}
 }
+
+func validTargetGroupHealthMinimumHealthyTargetsCount(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == targetGroupHealthOff {
+		return
+	}
+
+	if count, err := strconv.Atoi(value); err != nil || count < 1 {
+		errors = append(errors, fmt.Errorf("%q must be a positive integer or %q", k, targetGroupHealthOff))
+	}
+
+	return
+}
+
+func validTargetGroupHealthMinimumHealthyTargetsPercentage(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == targetGroupHealthOff {
+		return
+	}
+
+	if percentage, err := strconv.Atoi(value); err != nil || percentage < 1 || percentage > 100 {
+		errors = append(errors, fmt.Errorf("%q must be an integer between 1 and 100 or %q", k, targetGroupHealthOff))
+	}
+
+	return
+}
+
+func expandTargetGroupHealthAttributes(tfList []interface{}) []*elbv2.TargetGroupAttribute {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	var apiObjects []*elbv2.TargetGroupAttribute
+
+	if v, ok := tfMap["dns_failover"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		dnsFailover := v[0].(map[string]interface{})
+
+		apiObjects = append(apiObjects,
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String("target_group_health.dns_failover.minimum_healthy_targets.count"),
+				Value: aws.String(dnsFailover["minimum_healthy_targets_count"].(string)),
+			},
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String("target_group_health.dns_failover.minimum_healthy_targets.percentage"),
+				Value: aws.String(dnsFailover["minimum_healthy_targets_percentage"].(string)),
+			})
+	}
+
+	if v, ok := tfMap["unhealthy_state_routing"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		unhealthyStateRouting := v[0].(map[string]interface{})
+
+		apiObjects = append(apiObjects,
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String("target_group_health.unhealthy_state_routing.minimum_healthy_targets.count"),
+				Value: aws.String(strconv.Itoa(unhealthyStateRouting["minimum_healthy_targets_count"].(int))),
+			},
+			&elbv2.TargetGroupAttribute{
+				Key:   aws.String("target_group_health.unhealthy_state_routing.minimum_healthy_targets.percentage"),
+				Value: aws.String(unhealthyStateRouting["minimum_healthy_targets_percentage"].(string)),
+			})
+	}
+
+	return apiObjects
+}
+
+func expandTargetHealthStateAttributes(tfList []interface{}) []*elbv2.TargetGroupAttribute {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	enabled := tfMap["enable_unhealthy_connection_termination"].(bool)
+
+	apiObjects := []*elbv2.TargetGroupAttribute{
+		{
+			Key:   aws.String("target_health_state.unhealthy.connection_termination.enabled"),
+			Value: aws.String(strconv.FormatBool(enabled)),
+		},
+	}
+
+	// The draining interval is only accepted when connection termination is disabled.
+	if !enabled {
+		apiObjects = append(apiObjects, &elbv2.TargetGroupAttribute{
+			Key:   aws.String("target_health_state.unhealthy.draining_interval_seconds"),
+			Value: aws.String(strconv.Itoa(tfMap["unhealthy_draining_interval"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenTargetGroupHealthAttributes(attributes []*elbv2.TargetGroupAttribute) ([]interface{}, error) {
+	dnsFailover := make(map[string]interface{})
+	unhealthyStateRouting := make(map[string]interface{})
+
+	for _, attr := range attributes {
+		switch aws.StringValue(attr.Key) {
+		case "target_group_health.dns_failover.minimum_healthy_targets.count":
+			dnsFailover["minimum_healthy_targets_count"] = aws.StringValue(attr.Value)
+		case "target_group_health.dns_failover.minimum_healthy_targets.percentage":
+			dnsFailover["minimum_healthy_targets_percentage"] = aws.StringValue(attr.Value)
+		case "target_group_health.unhealthy_state_routing.minimum_healthy_targets.count":
+			count, err := strconv.Atoi(aws.StringValue(attr.Value))
+			if err != nil {
+				return nil, fmt.Errorf("error converting target_group_health.unhealthy_state_routing.minimum_healthy_targets.count to int: %s", aws.StringValue(attr.Value))
+			}
+			unhealthyStateRouting["minimum_healthy_targets_count"] = count
+		case "target_group_health.unhealthy_state_routing.minimum_healthy_targets.percentage":
+			unhealthyStateRouting["minimum_healthy_targets_percentage"] = aws.StringValue(attr.Value)
+		}
+	}
+
+	if len(dnsFailover) == 0 && len(unhealthyStateRouting) == 0 {
+		return nil, nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if len(dnsFailover) > 0 {
+		tfMap["dns_failover"] = []interface{}{dnsFailover}
+	}
+
+	if len(unhealthyStateRouting) > 0 {
+		tfMap["unhealthy_state_routing"] = []interface{}{unhealthyStateRouting}
+	}
+
+	return []interface{}{tfMap}, nil
+}
+
+func flattenTargetHealthStateAttributes(attributes []*elbv2.TargetGroupAttribute) ([]interface{}, error) {
+	tfMap := make(map[string]interface{})
+
+	for _, attr := range attributes {
+		switch aws.StringValue(attr.Key) {
+		case "target_health_state.unhealthy.connection_termination.enabled":
+			enabled, err := strconv.ParseBool(aws.StringValue(attr.Value))
+			if err != nil {
+				return nil, fmt.Errorf("error converting target_health_state.unhealthy.connection_termination.enabled to bool: %s", aws.StringValue(attr.Value))
+			}
+			tfMap["enable_unhealthy_connection_termination"] = enabled
+		case "target_health_state.unhealthy.draining_interval_seconds":
+			interval, err := strconv.Atoi(aws.StringValue(attr.Value))
+			if err != nil {
+				return nil, fmt.Errorf("error converting target_health_state.unhealthy.draining_interval_seconds to int: %s", aws.StringValue(attr.Value))
+			}
+			tfMap["unhealthy_draining_interval"] = interval
+		}
+	}
+
+	if len(tfMap) == 0 {
+		return nil, nil
+	}
+
+	return []interface{}{tfMap}, nil
+}
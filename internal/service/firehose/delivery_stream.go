@@ -695,6 +695,70 @@ func flattenProcessingConfiguration(pc *firehose.ProcessingConfiguration, roleAr
 	return processingConfiguration
 }
 
+// alignProcessorParameters reorders the processor parameters of a flattened destination
+// configuration to follow the configured order. The API does not preserve parameter order.
+func alignProcessorParameters(tfList []map[string]interface{}, configuredProcessors []interface{}) []map[string]interface{} {
+	if len(tfList) == 0 || len(configuredProcessors) == 0 {
+		return tfList
+	}
+
+	pc, ok := tfList[0]["processing_configuration"].([]map[string]interface{})
+
+	if !ok || len(pc) == 0 {
+		return tfList
+	}
+
+	processors, ok := pc[0]["processors"].([]interface{})
+
+	if !ok {
+		return tfList
+	}
+
+	for i, v := range processors {
+		if i >= len(configuredProcessors) {
+			break
+		}
+
+		processor := v.(map[string]interface{})
+		configuredProcessor, ok := configuredProcessors[i].(map[string]interface{})
+
+		if !ok || configuredProcessor["type"] != processor["type"] {
+			continue
+		}
+
+		configuredParameters, _ := configuredProcessor["parameters"].([]interface{})
+		parameters := processor["parameters"].([]interface{})
+		ordered := make([]interface{}, 0, len(parameters))
+		used := make([]bool, len(parameters))
+
+		for _, configuredParameter := range configuredParameters {
+			configuredParameter, ok := configuredParameter.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			for j, parameter := range parameters {
+				if !used[j] && parameter.(map[string]interface{})["parameter_name"] == configuredParameter["parameter_name"] {
+					ordered = append(ordered, parameter)
+					used[j] = true
+					break
+				}
+			}
+		}
+
+		for j, parameter := range parameters {
+			if !used[j] {
+				ordered = append(ordered, parameter)
+			}
+		}
+
+		processor["parameters"] = ordered
+	}
+
+	return tfList
+}
+
 func flattenDynamicPartitioningConfiguration(dpc *firehose.DynamicPartitioningConfiguration) []map[string]interface{} {
 	if dpc == nil {
 		return []map[string]interface{}{}
@@ -762,7 +826,7 @@ func flattenDeliveryStream(d *schema.ResourceData, s *firehose.DeliveryStreamDes
 		if destination.RedshiftDestinationDescription != nil {
 			d.Set("destination", destinationTypeRedshift)
 			configuredPassword := d.Get("redshift_configuration.0.password").(string)
-			if err := d.Set("redshift_configuration", flattenRedshiftConfiguration(destination.RedshiftDestinationDescription, configuredPassword)); err != nil {
+			if err := d.Set("redshift_configuration", alignProcessorParameters(flattenRedshiftConfiguration(destination.RedshiftDestinationDescription, configuredPassword), d.Get("redshift_configuration.0.processing_configuration.0.processors").([]interface{}))); err != nil {
 				return fmt.Errorf("error setting redshift_configuration: %s", err)
 			}
 			if err := d.Set("s3_configuration", flattenS3Configuration(destination.RedshiftDestinationDescription.S3DestinationDescription)); err != nil {
@@ -770,7 +834,7 @@ func flattenDeliveryStream(d *schema.ResourceData, s *firehose.DeliveryStreamDes
 			}
 		} else if destination.ElasticsearchDestinationDescription != nil {
 			d.Set("destination", destinationTypeElasticsearch)
-			if err := d.Set("elasticsearch_configuration", flattenElasticsearchConfiguration(destination.ElasticsearchDestinationDescription)); err != nil {
+			if err := d.Set("elasticsearch_configuration", alignProcessorParameters(flattenElasticsearchConfiguration(destination.ElasticsearchDestinationDescription), d.Get("elasticsearch_configuration.0.processing_configuration.0.processors").([]interface{}))); err != nil {
 				return fmt.Errorf("error setting elasticsearch_configuration: %s", err)
 			}
 			if err := d.Set("s3_configuration", flattenS3Configuration(destination.ElasticsearchDestinationDescription.S3DestinationDescription)); err != nil {
@@ -778,7 +842,7 @@ func flattenDeliveryStream(d *schema.ResourceData, s *firehose.DeliveryStreamDes
 			}
 		} else if destination.SplunkDestinationDescription != nil {
 			d.Set("destination", destinationTypeSplunk)
-			if err := d.Set("splunk_configuration", flattenSplunkConfiguration(destination.SplunkDestinationDescription)); err != nil {
+			if err := d.Set("splunk_configuration", alignProcessorParameters(flattenSplunkConfiguration(destination.SplunkDestinationDescription), d.Get("splunk_configuration.0.processing_configuration.0.processors").([]interface{}))); err != nil {
 				return fmt.Errorf("error setting splunk_configuration: %s", err)
 			}
 			if err := d.Set("s3_configuration", flattenS3Configuration(destination.SplunkDestinationDescription.S3DestinationDescription)); err != nil {
@@ -787,7 +851,7 @@ func flattenDeliveryStream(d *schema.ResourceData, s *firehose.DeliveryStreamDes
 		} else if destination.HttpEndpointDestinationDescription != nil {
 			d.Set("destination", destinationTypeHttpEndpoint)
 			configuredAccessKey := d.Get("http_endpoint_configuration.0.access_key").(string)
-			if err := d.Set("http_endpoint_configuration", flattenHTTPEndpointConfiguration(destination.HttpEndpointDestinationDescription, configuredAccessKey)); err != nil {
+			if err := d.Set("http_endpoint_configuration", alignProcessorParameters(flattenHTTPEndpointConfiguration(destination.HttpEndpointDestinationDescription, configuredAccessKey), d.Get("http_endpoint_configuration.0.processing_configuration.0.processors").([]interface{}))); err != nil {
 				return fmt.Errorf("error setting http_endpoint_configuration: %s", err)
 			}
 			if err := d.Set("s3_configuration", flattenS3Configuration(destination.HttpEndpointDestinationDescription.S3DestinationDescription)); err != nil {
@@ -800,7 +864,7 @@ func flattenDeliveryStream(d *schema.ResourceData, s *firehose.DeliveryStreamDes
 			}
 		} else {
 			d.Set("destination", destinationTypeExtendedS3)
-			if err := d.Set("extended_s3_configuration", flattenExtendedS3Configuration(destination.ExtendedS3DestinationDescription)); err != nil {
+			if err := d.Set("extended_s3_configuration", alignProcessorParameters(flattenExtendedS3Configuration(destination.ExtendedS3DestinationDescription), d.Get("extended_s3_configuration.0.processing_configuration.0.processors").([]interface{}))); err != nil {
 				return fmt.Errorf("error setting extended_s3_configuration: %s", err)
 			}
 		}
@@ -3,6 +3,7 @@ package glue
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"strings"
 
@@ -322,7 +323,6 @@ func ResourceCatalogTable() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 				MaxItems: 3,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -429,7 +429,7 @@ func resourceCatalogTableRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("view_expanded_text", table.ViewExpandedText)
 	d.Set("table_type", table.TableType)
 
-	if err := d.Set("parameters", aws.StringValueMap(table.Parameters)); err != nil {
+	if err := d.Set("parameters", tableParametersRemoveServiceManaged(aws.StringValueMap(table.Parameters), d.Get("parameters").(map[string]interface{}))); err != nil {
 		return fmt.Errorf("error setting parameters: %w", err)
 	}
 
@@ -451,10 +451,8 @@ func resourceCatalogTableRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error getting Glue Partition Indexes: %w", err)
 	}
 
-	if partOut != nil && len(partOut.PartitionIndexDescriptorList) > 0 {
-		if err := d.Set("partition_index", flattenGluePartitionIndexes(partOut.PartitionIndexDescriptorList)); err != nil {
-			return fmt.Errorf("error setting partition_index: %w", err)
-		}
+	if err := d.Set("partition_index", flattenGluePartitionIndexes(partOut.PartitionIndexDescriptorList)); err != nil {
+		return fmt.Errorf("error setting partition_index: %w", err)
 	}
 
 	return nil
@@ -463,19 +461,47 @@ func resourceCatalogTableRead(d *schema.ResourceData, meta interface{}) error {
 func resourceCatalogTableUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).GlueConn
 
-	catalogID, dbName, _, err := ReadTableID(d.Id())
+	catalogID, dbName, name, err := ReadTableID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	updateTableInput := &glue.UpdateTableInput{
-		CatalogId:    aws.String(catalogID),
-		DatabaseName: aws.String(dbName),
-		TableInput:   expandGlueTableInput(d),
+	if d.HasChangesExcept("partition_index") {
+		out, err := FindTableByName(conn, catalogID, dbName, name)
+
+		if err != nil {
+			return fmt.Errorf("Error reading Glue Catalog Table (%s): %w", d.Id(), err)
+		}
+
+		updateTableInput := &glue.UpdateTableInput{
+			CatalogId:    aws.String(catalogID),
+			DatabaseName: aws.String(dbName),
+			TableInput:   expandGlueTableInput(d),
+		}
+
+		// Carry over service-managed parameters that are not configured, so that the update does not remove them.
+		for _, k := range tableServiceManagedParameters {
+			if v, ok := out.Table.Parameters[k]; ok {
+				if _, ok := updateTableInput.TableInput.Parameters[k]; !ok {
+					if updateTableInput.TableInput.Parameters == nil {
+						updateTableInput.TableInput.Parameters = make(map[string]*string)
+					}
+					updateTableInput.TableInput.Parameters[k] = v
+				}
+			}
+		}
+
+		if _, err := conn.UpdateTable(updateTableInput); err != nil {
+			return fmt.Errorf("Error updating Glue Catalog Table: %w", err)
+		}
 	}
 
-	if _, err := conn.UpdateTable(updateTableInput); err != nil {
-		return fmt.Errorf("Error updating Glue Catalog Table: %w", err)
+	if d.HasChange("partition_index") {
+		o, n := d.GetChange("partition_index")
+
+		if err := updateTablePartitionIndexes(conn, catalogID, dbName, name, o.([]interface{}), n.([]interface{})); err != nil {
+			return fmt.Errorf("error updating Glue Catalog Table (%s) partition indexes: %w", d.Id(), err)
+		}
 	}
 
 	return resourceCatalogTableRead(d, meta)
@@ -554,6 +580,108 @@ func expandGlueTableInput(d *schema.ResourceData) *glue.TableInput {
 	return tableInput
 }
 
+// tableServiceManagedParameters are table parameters that Glue and Lake Formation set automatically.
+var tableServiceManagedParameters = []string{
+	"table_type",
+	"transient_lastDdlTime",
+}
+
+// tableParametersRemoveServiceManaged removes service-managed parameters that are not configured.
+func tableParametersRemoveServiceManaged(apiParameters map[string]string, configured map[string]interface{}) map[string]string {
+	for _, k := range tableServiceManagedParameters {
+		if _, ok := configured[k]; !ok {
+			delete(apiParameters, k)
+		}
+	}
+
+	return apiParameters
+}
+
+func updateTablePartitionIndexes(conn *glue.Glue, catalogID, dbName, tableName string, o, n []interface{}) error {
+	oldIndexes := make(map[string]*glue.PartitionIndex)
+	for _, tfMapRaw := range o {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			partitionIndex := expandGlueTablePartitionIndex(tfMap)
+			oldIndexes[aws.StringValue(partitionIndex.IndexName)] = partitionIndex
+		}
+	}
+
+	newIndexes := make(map[string]*glue.PartitionIndex)
+	for _, tfMapRaw := range n {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			partitionIndex := expandGlueTablePartitionIndex(tfMap)
+			newIndexes[aws.StringValue(partitionIndex.IndexName)] = partitionIndex
+		}
+	}
+
+	var del, add []*glue.PartitionIndex
+
+	for _, tfMapRaw := range o {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			name := tfMap["index_name"].(string)
+			if v, ok := newIndexes[name]; !ok || !reflect.DeepEqual(v.Keys, oldIndexes[name].Keys) {
+				del = append(del, oldIndexes[name])
+			}
+		}
+	}
+
+	for _, tfMapRaw := range n {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			name := tfMap["index_name"].(string)
+			if v, ok := oldIndexes[name]; !ok || !reflect.DeepEqual(v.Keys, newIndexes[name].Keys) {
+				add = append(add, newIndexes[name])
+			}
+		}
+	}
+
+	for _, partitionIndex := range del {
+		indexName := aws.StringValue(partitionIndex.IndexName)
+		id := createPartitionIndexID(catalogID, dbName, tableName, indexName)
+
+		log.Printf("[DEBUG] Deleting Glue Partition Index: %s", id)
+		_, err := conn.DeletePartitionIndex(&glue.DeletePartitionIndexInput{
+			CatalogId:    aws.String(catalogID),
+			DatabaseName: aws.String(dbName),
+			IndexName:    aws.String(indexName),
+			TableName:    aws.String(tableName),
+		})
+
+		if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting Glue Partition Index (%s): %w", id, err)
+		}
+
+		if _, err := waitGluePartitionIndexDeleted(conn, id, partitionIndexDeleteTimeout); err != nil {
+			return fmt.Errorf("error waiting for Glue Partition Index (%s) delete: %w", id, err)
+		}
+	}
+
+	for _, partitionIndex := range add {
+		id := createPartitionIndexID(catalogID, dbName, tableName, aws.StringValue(partitionIndex.IndexName))
+
+		log.Printf("[DEBUG] Creating Glue Partition Index: %s", id)
+		_, err := conn.CreatePartitionIndex(&glue.CreatePartitionIndexInput{
+			CatalogId:      aws.String(catalogID),
+			DatabaseName:   aws.String(dbName),
+			PartitionIndex: partitionIndex,
+			TableName:      aws.String(tableName),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error creating Glue Partition Index (%s): %w", id, err)
+		}
+
+		if _, err := waitGluePartitionIndexCreated(conn, id, partitionIndexCreateTimeout); err != nil {
+			return fmt.Errorf("error waiting for Glue Partition Index (%s) create: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
 func expandGlueTablePartitionIndexes(a []interface{}) []*glue.PartitionIndex {
 	partitionIndexes := make([]*glue.PartitionIndex, 0, len(a))
 
@@ -1,8 +1,33 @@
 package glue
 
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
 const (
 	devEndpointStatusFailed       = "FAILED"
 	devEndpointStatusProvisioning = "PROVISIONING"
 	devEndpointStatusReady        = "READY"
 	devEndpointStatusTerminating  = "TERMINATING"
 )
+
+const (
+	workerTypeG4X = "G.4X"
+	workerTypeG8X = "G.8X"
+	workerTypeZ2X = "Z.2X"
+)
+
+func workerType_Values() []string {
+	result := glue.WorkerType_Values()
+
+	for _, v := range []string{workerTypeG4X, workerTypeG8X, workerTypeZ2X} {
+		if _, ok := verify.SliceContainsString(flex.FlattenStringList(aws.StringSlice(result)), v); !ok {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
@@ -1,6 +1,7 @@
 package glue
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -26,7 +28,11 @@ func ResourceJob() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceJobCustomizeDiffWorkerType,
+			resourceJobCustomizeDiffWorkerTypeCommand,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -145,7 +151,7 @@ func ResourceJob() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"max_capacity"},
-				ValidateFunc:  validation.StringInSlice(glue.WorkerType_Values(), false),
+				ValidateFunc:  validation.StringInSlice(workerType_Values(), false),
 			},
 			"number_of_workers": {
 				Type:          schema.TypeInt,
@@ -524,3 +530,71 @@ func flattenGlueNotificationProperty(notificationProperty *glue.NotificationProp
 
 	return []map[string]interface{}{m}
 }
+
+// jobKnownGlueVersions are the Glue versions for which jobWorkerTypeGlueVersions is maintained.
+var jobKnownGlueVersions = []interface{}{"0.9", "1.0", "2.0", "3.0", "4.0"}
+
+// jobWorkerTypeGlueVersions maps worker types to the Glue versions that support them.
+// Worker types not listed here are supported by all known Glue versions.
+var jobWorkerTypeGlueVersions = map[string][]interface{}{
+	workerTypeG4X: {"3.0", "4.0"},
+	workerTypeG8X: {"3.0", "4.0"},
+	workerTypeZ2X: {"4.0"},
+}
+
+func resourceJobCustomizeDiffWorkerType(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("worker_type") || !diff.NewValueKnown("glue_version") {
+		return nil
+	}
+
+	workerType := diff.Get("worker_type").(string)
+	glueVersion := diff.Get("glue_version").(string)
+
+	if workerType == "" || glueVersion == "" {
+		return nil
+	}
+
+	glueVersions, ok := jobWorkerTypeGlueVersions[workerType]
+
+	if !ok {
+		return nil
+	}
+
+	if _, ok := verify.SliceContainsString(jobKnownGlueVersions, glueVersion); !ok {
+		log.Printf("[WARN] Unable to validate Glue Job worker_type (%s) for unknown glue_version (%s)", workerType, glueVersion)
+		return nil
+	}
+
+	if _, ok := verify.SliceContainsString(glueVersions, glueVersion); !ok {
+		return fmt.Errorf("worker_type %q is not supported with glue_version %q, supported versions: %v", workerType, glueVersion, glueVersions)
+	}
+
+	return nil
+}
+
+// jobWorkerTypeCommandNames maps worker types to the job command names that support them.
+// Worker types not listed here are supported by all job commands.
+var jobWorkerTypeCommandNames = map[string][]interface{}{
+	workerTypeZ2X: {"glueray"},
+}
+
+func resourceJobCustomizeDiffWorkerTypeCommand(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("worker_type") || !diff.NewValueKnown("command.0.name") {
+		return nil
+	}
+
+	workerType := diff.Get("worker_type").(string)
+	commandName := diff.Get("command.0.name").(string)
+
+	commandNames, ok := jobWorkerTypeCommandNames[workerType]
+
+	if !ok || commandName == "" {
+		return nil
+	}
+
+	if _, ok := verify.SliceContainsString(commandNames, commandName); !ok {
+		return fmt.Errorf("worker_type %q is not supported with command name %q, supported command names: %v", workerType, commandName, commandNames)
+	}
+
+	return nil
+}
@@ -595,6 +595,42 @@ func TestAccGlueJob_workerType(t *testing.T) {
 	})
 }
 
+func TestAccGlueJob_workerTypeGlueVersion(t *testing.T) {
+	var job glue.Job
+
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+	resourceName := "aws_glue_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, glue.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJobConfig_WorkerTypeGlueVersion(rName, "G.8X", "2.0"),
+				ExpectError: regexp.MustCompile(`worker_type "G.8X" is not supported with glue_version "2.0"`),
+			},
+			{
+				Config: testAccJobConfig_WorkerTypeGlueVersion(rName, "G.1X", "3.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "glue_version", "3.0"),
+					resource.TestCheckResourceAttr(resourceName, "worker_type", "G.1X"),
+				),
+			},
+			{
+				Config:      testAccJobConfig_WorkerTypeGlueVersion(rName, "Z.2X", "3.0"),
+				ExpectError: regexp.MustCompile(`worker_type "Z.2X" is not supported with glue_version "3.0"`),
+			},
+			{
+				Config:      testAccJobConfig_WorkerTypeGlueVersion(rName, "Z.2X", "4.0"),
+				ExpectError: regexp.MustCompile(`worker_type "Z.2X" is not supported with command name "glueetl"`),
+			},
+		},
+	})
+}
+
 func TestAccGlueJob_pythonShell(t *testing.T) {
 	var job glue.Job
 
@@ -1133,6 +1169,26 @@ resource "aws_glue_job" "test" {
 `, testAccJobConfig_Base(rName), rName, workerType)
 }
 
+func testAccJobConfig_WorkerTypeGlueVersion(rName, workerType, glueVersion string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "aws_glue_job" "test" {
+  glue_version      = "%s"
+  name              = "%s"
+  number_of_workers = 2
+  role_arn          = aws_iam_role.test.arn
+  worker_type       = "%s"
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, testAccJobConfig_Base(rName), glueVersion, rName, workerType)
+}
+
 func testAccJobConfig_PythonShell(rName string) string {
 	return fmt.Sprintf(`
 %s
@@ -13,6 +13,8 @@ import (
 const (
 	// Maximum amount of time to wait for an Operation to return Deleted
 	mlTransformDeleteTimeout      = 2 * time.Minute
+	partitionIndexCreateTimeout   = 10 * time.Minute
+	partitionIndexDeleteTimeout   = 10 * time.Minute
 	registryDeleteTimeout         = 2 * time.Minute
 	schemaAvailableTimeout        = 2 * time.Minute
 	schemaDeleteTimeout           = 2 * time.Minute
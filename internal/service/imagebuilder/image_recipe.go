@@ -194,6 +194,7 @@ func ResourceImageRecipe() *schema.Resource {
 						"uninstall_after_build": {
 							Type:     schema.TypeBool,
 							Required: true,
+							ForceNew: true,
 						},
 					},
 				},
@@ -580,7 +580,7 @@ func TestAccImageBuilderImageRecipe_systemsManagerAgent(t *testing.T) {
 		CheckDestroy: testAccCheckImageRecipeDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccImageRecipeSystemsManagerAgentConfig(rName),
+				Config: testAccImageRecipeSystemsManagerAgentConfig(rName, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckImageRecipeExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "systems_manager_agent.#", "1"),
@@ -592,6 +592,14 @@ func TestAccImageBuilderImageRecipe_systemsManagerAgent(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccImageRecipeSystemsManagerAgentConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageRecipeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "systems_manager_agent.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "systems_manager_agent.0.uninstall_after_build", "false"),
+				),
+			},
 		},
 	})
 }
@@ -1247,7 +1255,7 @@ resource "aws_imagebuilder_image_recipe" "test" {
 `, rName))
 }
 
-func testAccImageRecipeSystemsManagerAgentConfig(rName string) string {
+func testAccImageRecipeSystemsManagerAgentConfig(rName string, uninstallAfterBuild bool) string {
 	return acctest.ConfigCompose(
 		testAccImageRecipeBaseConfig(rName),
 		fmt.Sprintf(`
@@ -1261,10 +1269,10 @@ resource "aws_imagebuilder_image_recipe" "test" {
   version      = "1.0.0"
 
   systems_manager_agent {
-    uninstall_after_build = true
+    uninstall_after_build = %[2]t
   }
 }
-`, rName))
+`, rName, uninstallAfterBuild))
 }
 
 func testAccImageRecipeUserDataBase64Config(rName string) string {
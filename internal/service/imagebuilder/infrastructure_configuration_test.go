@@ -124,7 +124,7 @@ func TestAccImageBuilderInfrastructureConfiguration_instanceMetadataOptions(t *t
 		CheckDestroy: testAccCheckInfrastructureConfigurationDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccInfrastructureConfigurationInstanceMetadataOptionsConfig(rName),
+				Config: testAccInfrastructureConfigurationInstanceMetadataOptionsConfig(rName, 64, "required"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckInfrastructureConfigurationExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "instance_metadata_options.#", "1"),
@@ -137,6 +137,15 @@ func TestAccImageBuilderInfrastructureConfiguration_instanceMetadataOptions(t *t
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccInfrastructureConfigurationInstanceMetadataOptionsConfig(rName, 1, "optional"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInfrastructureConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_options.0.http_put_response_hop_limit", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_options.0.http_tokens", "optional"),
+				),
+			},
 		},
 	})
 }
@@ -649,7 +658,7 @@ resource "aws_imagebuilder_infrastructure_configuration" "test" {
 `, rName, description))
 }
 
-func testAccInfrastructureConfigurationInstanceMetadataOptionsConfig(rName string) string {
+func testAccInfrastructureConfigurationInstanceMetadataOptionsConfig(rName string, hopLimit int, httpTokens string) string {
 	return acctest.ConfigCompose(
 		testAccInfrastructureConfigurationBaseConfig(rName),
 		fmt.Sprintf(`
@@ -658,11 +667,11 @@ resource "aws_imagebuilder_infrastructure_configuration" "test" {
   name                  = %[1]q
 
   instance_metadata_options {
-    http_put_response_hop_limit = 64
-    http_tokens                 = "required"
+    http_put_response_hop_limit = %[2]d
+    http_tokens                 = %[3]q
   }
 }
-`, rName))
+`, rName, hopLimit, httpTokens))
 }
 
 func testAccInfrastructureConfigurationInstanceProfileName1Config(rName string) string {
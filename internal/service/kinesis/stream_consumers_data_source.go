@@ -0,0 +1,96 @@
+package kinesis
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceStreamConsumers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceStreamConsumersRead,
+
+		Schema: map[string]*schema.Schema{
+			"consumers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"stream_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceStreamConsumersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KinesisConn
+
+	streamArn := d.Get("stream_arn").(string)
+
+	input := &kinesis.ListStreamConsumersInput{
+		StreamARN: aws.String(streamArn),
+	}
+
+	var consumers []interface{}
+
+	err := conn.ListStreamConsumersPages(input, func(page *kinesis.ListStreamConsumersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, consumer := range page.Consumers {
+			if consumer == nil {
+				continue
+			}
+
+			consumers = append(consumers, map[string]interface{}{
+				"arn":                aws.StringValue(consumer.ConsumerARN),
+				"creation_timestamp": aws.TimeValue(consumer.ConsumerCreationTimestamp).Format(time.RFC3339),
+				"name":               aws.StringValue(consumer.ConsumerName),
+				"status":             aws.StringValue(consumer.ConsumerStatus),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Kinesis Stream (%s) Consumers: %w", streamArn, err)
+	}
+
+	d.SetId(streamArn)
+
+	if err := d.Set("consumers", consumers); err != nil {
+		return fmt.Errorf("error setting consumers: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package kinesis_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccKinesisStreamConsumersDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_kinesis_stream_consumers.test"
+	resourceName := "aws_kinesis_stream_consumer.test"
+	streamName := "aws_kinesis_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kinesis.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamConsumersDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", streamName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "consumers.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "consumers.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "consumers.0.name", resourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "consumers.0.creation_timestamp"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "consumers.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStreamConsumersDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccStreamConsumerBaseDataSourceConfig(rName),
+		fmt.Sprintf(`
+data "aws_kinesis_stream_consumers" "test" {
+  stream_arn = aws_kinesis_stream_consumer.test.stream_arn
+}
+
+resource "aws_kinesis_stream_consumer" "test" {
+  name       = %q
+  stream_arn = aws_kinesis_stream.test.arn
+}
+`, rName))
+}
@@ -255,6 +255,11 @@ func ResourceApplication() *schema.Resource {
 													ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.ConfigurationType_Values(), false),
 												},
 
+												"current_parallelism": {
+													Type:     schema.TypeInt,
+													Computed: true,
+												},
+
 												"parallelism": {
 													Type:         schema.TypeInt,
 													Optional:     true,
@@ -2633,6 +2638,7 @@ func flattenApplicationConfigurationDescription(applicationConfigurationDescript
 			mParallelismConfiguration := map[string]interface{}{
 				"auto_scaling_enabled": aws.BoolValue(parallelismConfigurationDescription.AutoScalingEnabled),
 				"configuration_type":   aws.StringValue(parallelismConfigurationDescription.ConfigurationType),
+				"current_parallelism":  int(aws.Int64Value(parallelismConfigurationDescription.CurrentParallelism)),
 				"parallelism":          int(aws.Int64Value(parallelismConfigurationDescription.Parallelism)),
 				"parallelism_per_kpu":  int(aws.Int64Value(parallelismConfigurationDescription.ParallelismPerKPU)),
 			}
@@ -439,7 +439,10 @@ func updateKmsKeyPolicy(conn *kms.KMS, keyID string, policy string, bypassPolicy
 		}
 
 		log.Printf("[DEBUG] Updating KMS Key policy: %s", input)
-		_, err = conn.PutKeyPolicy(input)
+		// As with CreateKey, any principal in the policy must be known to KMS.
+		_, err = WaitIAMPropagation(func() (interface{}, error) {
+			return conn.PutKeyPolicy(input)
+		})
 
 		return nil, err
 	}
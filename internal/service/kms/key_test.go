@@ -244,6 +244,34 @@ func TestAccKMSKey_Policy_iamRole(t *testing.T) {
 	})
 }
 
+func TestAccKMSKey_Policy_iamRoleUpdate(t *testing.T) {
+	var key1, key2 kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyNameConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(resourceName, &key1),
+				),
+			},
+			{
+				Config: testAccKeyPolicyIAMRoleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(resourceName, &key2),
+					testAccCheckKeyNotRecreated(&key1, &key2),
+				),
+			},
+		},
+	})
+}
+
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/11801
 func TestAccKMSKey_Policy_iamRoleOrder(t *testing.T) {
 	var key kms.KeyMetadata
@@ -381,6 +409,36 @@ func TestAccKMSKey_isEnabled(t *testing.T) {
 	})
 }
 
+func TestAccKMSKey_deletionWindow(t *testing.T) {
+	var key1, key2 kms.KeyMetadata
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyDeletionWindowConfig(rName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(resourceName, &key1),
+					resource.TestCheckResourceAttr(resourceName, "deletion_window_in_days", "7"),
+				),
+			},
+			{
+				Config: testAccKeyDeletionWindowConfig(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyExists(resourceName, &key2),
+					testAccCheckKeyNotRecreated(&key1, &key2),
+					resource.TestCheckResourceAttr(resourceName, "deletion_window_in_days", "10"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKMSKey_tags(t *testing.T) {
 	var key kms.KeyMetadata
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -514,6 +572,16 @@ func testAccCheckKeyExists(name string, key *kms.KeyMetadata) resource.TestCheck
 	}
 }
 
+func testAccCheckKeyNotRecreated(i, j *kms.KeyMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.KeyId) != aws.StringValue(j.KeyId) {
+			return fmt.Errorf("KMS Key recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccKeyConfig() string {
 	return `
 resource "aws_kms_key" "test" {}
@@ -529,6 +597,15 @@ resource "aws_kms_key" "test" {
 `, rName)
 }
 
+func testAccKeyDeletionWindowConfig(rName string, deletionWindowInDays int) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = %[2]d
+}
+`, rName, deletionWindowInDays)
+}
+
 func testAccKey_multiRegion(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
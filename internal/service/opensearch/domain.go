@@ -354,7 +354,7 @@ func ResourceDomain() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Computed:     true,
-							ValidateFunc: validation.StringInSlice(opensearchservice.TLSSecurityPolicy_Values(), false),
+							ValidateFunc: validation.StringInSlice(TLSSecurityPolicy_Values(), false),
 						},
 					},
 				},
@@ -140,6 +140,13 @@ func TestAccOpenSearchDomain_requireHTTPS(t *testing.T) {
 					testAccCheckDomainEndpointOptions(true, "Policy-Min-TLS-1-2-2019-07", &domain),
 				),
 			},
+			{
+				Config: testAccDomainConfig_domainEndpointOptions(rName, true, "Policy-Min-TLS-1-2-PFS-2023-10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainExists("aws_opensearch_domain.test", &domain),
+					testAccCheckDomainEndpointOptions(true, "Policy-Min-TLS-1-2-PFS-2023-10", &domain),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,14 @@
+package opensearch
+
+import (
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+)
+
+const (
+	// Not yet defined in the AWS SDK for Go.
+	TLSSecurityPolicyPolicyMinTLS12PFS202310 = "Policy-Min-TLS-1-2-PFS-2023-10"
+)
+
+func TLSSecurityPolicy_Values() []string {
+	return append(opensearchservice.TLSSecurityPolicy_Values(), TLSSecurityPolicyPolicyMinTLS12PFS202310)
+}
@@ -20,6 +20,11 @@ func init() {
 		Name: "aws_opensearch_domain",
 		F:    sweepDomains,
 	})
+
+	resource.AddTestSweepers("aws_opensearch_package", &resource.Sweeper{
+		Name: "aws_opensearch_package",
+		F:    sweepPackages,
+	})
 }
 
 func sweepDomains(region string) error {
@@ -98,3 +103,48 @@ func sweepDomains(region string) error {
 
 	return errs.ErrorOrNil()
 }
+
+func sweepPackages(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).OpenSearchConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	input := &opensearchservice.DescribePackagesInput{}
+
+	err = conn.DescribePackagesPages(input, func(page *opensearchservice.DescribePackagesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PackageDetailsList {
+			r := ResourcePackage()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.PackageID))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping OpenSearch Package sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing OpenSearch Packages (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping OpenSearch Packages (%s): %w", region, err)
+	}
+
+	return nil
+}
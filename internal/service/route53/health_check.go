@@ -114,6 +114,10 @@ func ResourceHealthCheck() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntAtMost(256),
 			},
+			"child_health_checks": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 
 			"cloudwatch_alarm_name": {
 				Type:     schema.TypeString,
@@ -121,8 +125,9 @@ func ResourceHealthCheck() *schema.Resource {
 			},
 
 			"cloudwatch_alarm_region": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(route53.CloudWatchRegion_Values(), false),
 			},
 
 			"insufficient_data_health_status": {
@@ -338,6 +343,7 @@ func resourceHealthCheckRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting child_healthchecks: %w", err)
 	}
 
+	d.Set("child_health_checks", len(healthCheckConfig.ChildHealthChecks))
 	d.Set("child_health_threshold", healthCheckConfig.HealthThreshold)
 	d.Set("insufficient_data_health_status", healthCheckConfig.InsufficientDataHealthStatus)
 	d.Set("enable_sni", healthCheckConfig.EnableSNI)
@@ -146,6 +146,7 @@ func TestAccRoute53HealthCheck_withChildHealthChecks(t *testing.T) {
 				Config: testAccRoute53HealthCheckConfig_withChildHealthChecks,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRoute53HealthCheckExists(resourceName, &check),
+					resource.TestCheckResourceAttr(resourceName, "child_health_checks", "1"),
 				),
 			},
 			{
@@ -257,6 +258,7 @@ func TestAccRoute53HealthCheck_cloudWatchAlarmCheck(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRoute53HealthCheckExists(resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "cloudwatch_alarm_name", "cloudwatch-healthcheck-alarm"),
+					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_alarm_region", "data.aws_region.current", "name"),
 				),
 			},
 			{
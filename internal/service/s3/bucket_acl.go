@@ -147,6 +147,10 @@ func resourceBucketAclCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.AccessControlPolicy = expandBucketAclAccessControlPolicy(v.([]interface{}))
 	}
 
+	if err := checkBucketACLObjectOwnership(ctx, conn, bucket, expectedBucketOwner, input); err != nil {
+		return diag.FromErr(err)
+	}
+
 	_, err := verify.RetryOnAWSCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
 		return conn.PutBucketAclWithContext(ctx, input)
 	})
@@ -227,6 +231,10 @@ func resourceBucketAclUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		input.ACL = aws.String(acl)
 	}
 
+	if err := checkBucketACLObjectOwnership(ctx, conn, bucket, expectedBucketOwner, input); err != nil {
+		return diag.FromErr(err)
+	}
+
 	_, err = conn.PutBucketAclWithContext(ctx, input)
 
 	if err != nil {
@@ -246,6 +254,95 @@ func resourceBucketAclDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return nil
 }
 
+// checkBucketACLObjectOwnership returns an error if the bucket's object ownership setting
+// disables ACLs and the ACL to be applied grants access to anyone other than the bucket owner.
+func checkBucketACLObjectOwnership(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string, input *s3.PutBucketAclInput) error {
+	objectOwnership, err := findBucketObjectOwnership(ctx, conn, bucket, expectedBucketOwner)
+
+	// The caller may not be allowed to read ownership controls; let S3 decide whether the ACL is accepted.
+	if err != nil {
+		log.Printf("[WARN] Unable to read S3 bucket (%s) ownership controls, skipping ACL check: %s", bucket, err)
+		return nil
+	}
+
+	if !BucketACLSupportedByObjectOwnership(objectOwnership, aws.StringValue(input.ACL), input.AccessControlPolicy) {
+		return fmt.Errorf("S3 bucket (%s) has object ownership %s, which disables ACLs; only an ACL granting full control to the bucket owner (e.g. %q) can be set", bucket, objectOwnership, s3.BucketCannedACLPrivate)
+	}
+
+	return nil
+}
+
+// findBucketObjectOwnership returns the bucket's object ownership setting, or "" if no ownership controls are configured.
+func findBucketObjectOwnership(ctx context.Context, conn *s3.S3, bucket, expectedBucketOwner string) (string, error) {
+	input := &s3.GetBucketOwnershipControlsInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketOwnershipControlsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeOwnershipControlsNotFound, s3.ErrCodeNoSuchBucket) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.OwnershipControls == nil {
+		return "", nil
+	}
+
+	for _, rule := range output.OwnershipControls.Rules {
+		if rule == nil {
+			continue
+		}
+
+		return aws.StringValue(rule.ObjectOwnership), nil
+	}
+
+	return "", nil
+}
+
+// BucketACLSupportedByObjectOwnership returns whether the specified canned ACL and access control policy
+// can be applied to a bucket with the specified object ownership setting.
+// With BucketOwnerEnforced only ACLs that grant full control to the bucket owner are accepted.
+func BucketACLSupportedByObjectOwnership(objectOwnership, acl string, policy *s3.AccessControlPolicy) bool {
+	if objectOwnership != s3.ObjectOwnershipBucketOwnerEnforced {
+		return true
+	}
+
+	if acl != "" && acl != s3.BucketCannedACLPrivate {
+		return false
+	}
+
+	if policy == nil {
+		return true
+	}
+
+	var ownerID string
+	if policy.Owner != nil {
+		ownerID = aws.StringValue(policy.Owner.ID)
+	}
+
+	for _, grant := range policy.Grants {
+		if grant == nil || grant.Grantee == nil {
+			continue
+		}
+
+		if aws.StringValue(grant.Permission) != s3.PermissionFullControl ||
+			aws.StringValue(grant.Grantee.Type) != s3.TypeCanonicalUser ||
+			aws.StringValue(grant.Grantee.ID) != ownerID {
+			return false
+		}
+	}
+
+	return true
+}
+
 func expandBucketAclAccessControlPolicy(l []interface{}) *s3.AccessControlPolicy {
 	if len(l) == 0 || l[0] == nil {
 		return nil
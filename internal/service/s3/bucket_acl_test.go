@@ -246,6 +246,87 @@ func TestBucketACLParseResourceID(t *testing.T) {
 	}
 }
 
+func TestBucketACLSupportedByObjectOwnership(t *testing.T) {
+	owner := &s3.Owner{ID: aws.String("owner")}
+	ownerFullControl := &s3.Grant{
+		Grantee: &s3.Grantee{
+			ID:   aws.String("owner"),
+			Type: aws.String(s3.TypeCanonicalUser),
+		},
+		Permission: aws.String(s3.PermissionFullControl),
+	}
+
+	testCases := []struct {
+		TestName        string
+		ObjectOwnership string
+		ACL             string
+		Policy          *s3.AccessControlPolicy
+		Expected        bool
+	}{
+		{
+			TestName:        "no ownership controls",
+			ObjectOwnership: "",
+			ACL:             s3.BucketCannedACLPublicRead,
+			Expected:        true,
+		},
+		{
+			TestName:        "object writer",
+			ObjectOwnership: s3.ObjectOwnershipObjectWriter,
+			ACL:             s3.BucketCannedACLPublicRead,
+			Expected:        true,
+		},
+		{
+			TestName:        "enforced with private ACL",
+			ObjectOwnership: s3.ObjectOwnershipBucketOwnerEnforced,
+			ACL:             s3.BucketCannedACLPrivate,
+			Expected:        true,
+		},
+		{
+			TestName:        "enforced with public-read ACL",
+			ObjectOwnership: s3.ObjectOwnershipBucketOwnerEnforced,
+			ACL:             s3.BucketCannedACLPublicRead,
+			Expected:        false,
+		},
+		{
+			TestName:        "enforced with owner full control grant",
+			ObjectOwnership: s3.ObjectOwnershipBucketOwnerEnforced,
+			Policy: &s3.AccessControlPolicy{
+				Grants: []*s3.Grant{ownerFullControl},
+				Owner:  owner,
+			},
+			Expected: true,
+		},
+		{
+			TestName:        "enforced with additional grant",
+			ObjectOwnership: s3.ObjectOwnershipBucketOwnerEnforced,
+			Policy: &s3.AccessControlPolicy{
+				Grants: []*s3.Grant{
+					ownerFullControl,
+					{
+						Grantee: &s3.Grantee{
+							Type: aws.String(s3.TypeGroup),
+							URI:  aws.String("http://acs.amazonaws.com/groups/s3/LogDelivery"),
+						},
+						Permission: aws.String(s3.PermissionWrite),
+					},
+				},
+				Owner: owner,
+			},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := tfs3.BucketACLSupportedByObjectOwnership(testCase.ObjectOwnership, testCase.ACL, testCase.Policy)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestAccS3BucketAcl_basic(t *testing.T) {
 	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_s3_bucket_acl.test"
@@ -303,6 +384,23 @@ func TestAccS3BucketAcl_disappears(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketAcl_objectOwnershipEnforced(t *testing.T) {
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, s3.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketAcl_ObjectOwnershipEnforcedConfig(bucketName, s3.BucketCannedACLPublicRead),
+				ExpectError: regexp.MustCompile(`has object ownership BucketOwnerEnforced, which disables ACLs`),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketAcl_migrate_aclNoChange(t *testing.T) {
 	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	bucketResourceName := "aws_s3_bucket.test"
@@ -681,6 +779,27 @@ resource "aws_s3_bucket_acl" "test" {
 `, rName, acl)
 }
 
+func testAccBucketAcl_ObjectOwnershipEnforcedConfig(rName, acl string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_ownership_controls" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    object_ownership = "BucketOwnerEnforced"
+  }
+}
+
+resource "aws_s3_bucket_acl" "test" {
+  bucket = aws_s3_bucket_ownership_controls.test.bucket
+  acl    = %[2]q
+}
+`, rName, acl)
+}
+
 func testAccBucketAcl_GrantsConfig(bucketName string) string {
 	return fmt.Sprintf(`
 data "aws_canonical_user_id" "current" {}
@@ -274,6 +274,59 @@ func TestAccS3BucketLifecycleConfiguration_Filter_ObjectSizeRangeAndPrefix(t *te
 	})
 }
 
+func TestAccS3BucketLifecycleConfiguration_Filter_ObjectSizeRangeAndPrefix_transition(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketLifecycleConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleConfiguration_filterWithObjectSizeRangeAndPrefixTransitionConfig(rName, 128000, 1048576),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(resourceName),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"filter.#":       "1",
+						"filter.0.and.#": "1",
+						"filter.0.and.0.object_size_greater_than": "128000",
+						"filter.0.and.0.object_size_less_than":    "1048576",
+						"filter.0.and.0.prefix":                   rName,
+						"id":                                      rName,
+						"status":                                  tfs3.LifecycleRuleStatusEnabled,
+						"transition.#":                            "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*.transition.*", map[string]string{
+						"days":          "30",
+						"storage_class": s3.StorageClassStandardIa,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketLifecycleConfiguration_filterWithObjectSizeRangeAndPrefixTransitionConfig(rName, 256000, 2097152),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(resourceName),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"filter.#":       "1",
+						"filter.0.and.#": "1",
+						"filter.0.and.0.object_size_greater_than": "256000",
+						"filter.0.and.0.object_size_less_than":    "2097152",
+						"filter.0.and.0.prefix":                   rName,
+						"transition.#":                            "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketLifecycleConfiguration_disableRule(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_s3_bucket_lifecycle_configuration.test"
@@ -1704,6 +1757,42 @@ resource "aws_s3_bucket_lifecycle_configuration" "test" {
 `, rName, date, sizeGreaterThan, sizeLessThan)
 }
 
+func testAccBucketLifecycleConfiguration_filterWithObjectSizeRangeAndPrefixTransitionConfig(rName string, sizeGreaterThan, sizeLessThan int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_acl" "test" {
+  bucket = aws_s3_bucket.test.id
+  acl    = "private"
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  rule {
+    id = %[1]q
+
+    filter {
+      and {
+        object_size_greater_than = %[2]d
+        object_size_less_than    = %[3]d
+        prefix                   = %[1]q
+      }
+    }
+
+    transition {
+      days          = 30
+      storage_class = "STANDARD_IA"
+    }
+
+    status = "Enabled"
+  }
+}
+`, rName, sizeGreaterThan, sizeLessThan)
+}
+
 func testAccBucketLifecycleConfiguration_Filter_ObjectSizeGreaterThanAndPrefixConfig(rName, prefix string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -57,7 +57,7 @@ func ResourceBucketServerSideEncryptionConfiguration() *schema.Resource {
 									"sse_algorithm": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+										ValidateFunc: validation.StringInSlice(ServerSideEncryption_Values(), false),
 									},
 								},
 							},
@@ -15,6 +15,8 @@ const (
 
 	LifecycleRuleStatusEnabled  = "Enabled"
 	LifecycleRuleStatusDisabled = "Disabled"
+
+	ServerSideEncryptionAwsKmsDsse = "aws:kms:dsse"
 )
 
 func BucketCannedACL_Values() []string {
@@ -30,6 +32,12 @@ func BucketVersioningStatus_Values() []string {
 	return result
 }
 
+func ServerSideEncryption_Values() []string {
+	result := s3.ServerSideEncryption_Values()
+	result = appendUniqueString(result, ServerSideEncryptionAwsKmsDsse)
+	return result
+}
+
 func appendUniqueString(slice []string, elem string) []string {
 	for _, e := range slice {
 		if e == elem {
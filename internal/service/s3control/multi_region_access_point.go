@@ -168,6 +168,13 @@ func resourceMultiRegionAccessPointCreate(d *schema.ResourceData, meta interface
 		return fmt.Errorf("error waiting for Multi-Region Access Point (%s) create: %s", d.Id(), err)
 	}
 
+	// The create operation can succeed before the access point is ready in every Region.
+	_, err = waitMultiRegionAccessPointReady(conn, accountID, aws.StringValue(input.Details.Name), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Multi-Region Access Point (%s) to become ready: %w", d.Id(), err)
+	}
+
 	return resourceMultiRegionAccessPointRead(d, meta)
 }
 
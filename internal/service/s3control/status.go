@@ -92,3 +92,19 @@ func statusMultiRegionAccessPointRequest(conn *s3control.S3Control, accountID st
 		return output, aws.StringValue(output.RequestStatus), nil
 	}
 }
+
+func statusMultiRegionAccessPoint(conn *s3control.S3Control, accountID string, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindMultiRegionAccessPointByAccountIDAndName(conn, accountID, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,101 @@
+package s3control
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3control"
+)
+
+func TestStatusMultiRegionAccessPoint(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Output        *s3control.GetMultiRegionAccessPointOutput
+		Error         error
+		ExpectedState string
+		ExpectError   bool
+	}{
+		{
+			Name:          "not found",
+			Error:         awserr.New(errCodeNoSuchMultiRegionAccessPoint, "not found", nil),
+			ExpectedState: "",
+		},
+		{
+			Name:          "empty result",
+			Output:        &s3control.GetMultiRegionAccessPointOutput{},
+			ExpectedState: "",
+		},
+		{
+			Name: "creating",
+			Output: &s3control.GetMultiRegionAccessPointOutput{
+				AccessPoint: &s3control.MultiRegionAccessPointReport{
+					Status: aws.String(s3control.MultiRegionAccessPointStatusCreating),
+				},
+			},
+			ExpectedState: s3control.MultiRegionAccessPointStatusCreating,
+		},
+		{
+			Name: "partially created",
+			Output: &s3control.GetMultiRegionAccessPointOutput{
+				AccessPoint: &s3control.MultiRegionAccessPointReport{
+					Status: aws.String(s3control.MultiRegionAccessPointStatusPartiallyCreated),
+				},
+			},
+			ExpectedState: s3control.MultiRegionAccessPointStatusPartiallyCreated,
+		},
+		{
+			Name: "ready",
+			Output: &s3control.GetMultiRegionAccessPointOutput{
+				AccessPoint: &s3control.MultiRegionAccessPointReport{
+					Status: aws.String(s3control.MultiRegionAccessPointStatusReady),
+				},
+			},
+			ExpectedState: s3control.MultiRegionAccessPointStatusReady,
+		},
+		{
+			Name:          "other error",
+			Error:         awserr.New("AccessDenied", "access denied", nil),
+			ExpectedState: "",
+			ExpectError:   true,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := s3control.New(sess)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				if testCase.Error != nil {
+					r.Error = testCase.Error
+					return
+				}
+
+				data := r.Data.(*s3control.GetMultiRegionAccessPointOutput)
+				*data = *testCase.Output
+			})
+
+			_, state, err := statusMultiRegionAccessPoint(conn, "123456789012", "test")()
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if state != testCase.ExpectedState {
+				t.Errorf("expected state %q, got %q", testCase.ExpectedState, state)
+			}
+		})
+	}
+}
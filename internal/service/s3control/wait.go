@@ -24,6 +24,10 @@ const (
 	multiRegionAccessPointRequestSucceededMinTimeout = 5 * time.Second
 
 	multiRegionAccessPointRequestSucceededDelay = 15 * time.Second
+
+	multiRegionAccessPointReadyMinTimeout = 10 * time.Second
+
+	multiRegionAccessPointReadyDelay = 5 * time.Second
 )
 
 func waitPublicAccessBlockConfigurationBlockPublicACLsUpdated(conn *s3control.S3Control, accountID string, expectedValue bool) (*s3control.PublicAccessBlockConfiguration, error) {
@@ -119,3 +123,22 @@ func waitMultiRegionAccessPointRequestSucceeded(conn *s3control.S3Control, accou
 
 	return nil, err
 }
+
+func waitMultiRegionAccessPointReady(conn *s3control.S3Control, accountID string, name string, timeout time.Duration) (*s3control.MultiRegionAccessPointReport, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{s3control.MultiRegionAccessPointStatusCreating, s3control.MultiRegionAccessPointStatusPartiallyCreated},
+		Target:     []string{s3control.MultiRegionAccessPointStatusReady},
+		Timeout:    timeout,
+		Refresh:    statusMultiRegionAccessPoint(conn, accountID, name),
+		MinTimeout: multiRegionAccessPointReadyMinTimeout,
+		Delay:      multiRegionAccessPointReadyDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*s3control.MultiRegionAccessPointReport); ok {
+		return output, err
+	}
+
+	return nil, err
+}
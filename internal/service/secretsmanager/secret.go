@@ -140,6 +140,14 @@ func ResourceSecret() *schema.Resource {
 							Type:     schema.TypeInt,
 							Required: true,
 						},
+						"duration": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"schedule_expression": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
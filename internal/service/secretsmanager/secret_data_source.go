@@ -62,6 +62,14 @@ func DataSourceSecret() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+						"duration": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"schedule_expression": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
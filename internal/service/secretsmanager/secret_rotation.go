@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -31,6 +32,15 @@ func ResourceSecretRotation() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"last_rotated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"rotate_immediately": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"rotation_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -46,8 +56,21 @@ func ResourceSecretRotation() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"automatically_after_days": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ExactlyOneOf: []string{"rotation_rules.0.automatically_after_days", "rotation_rules.0.schedule_expression"},
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+						"duration": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validSecretRotationDuration,
+						},
+						"schedule_expression": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"rotation_rules.0.automatically_after_days", "rotation_rules.0.schedule_expression"},
+							ValidateFunc: validSecretRotationScheduleExpression,
 						},
 					},
 				},
@@ -63,6 +86,7 @@ func resourceSecretRotationCreate(d *schema.ResourceData, meta interface{}) erro
 
 	if v, ok := d.GetOk("rotation_lambda_arn"); ok && v.(string) != "" {
 		input := &secretsmanager.RotateSecretInput{
+			RotateImmediately: aws.Bool(d.Get("rotate_immediately").(bool)),
 			RotationLambdaARN: aws.String(v.(string)),
 			RotationRules:     expandSecretsManagerRotationRules(d.Get("rotation_rules").([]interface{})),
 			SecretId:          aws.String(secretID),
@@ -144,6 +168,12 @@ func resourceSecretRotationRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("secret_id", d.Id())
 	d.Set("rotation_enabled", output.RotationEnabled)
 
+	if output.LastRotatedDate != nil {
+		d.Set("last_rotated_date", aws.TimeValue(output.LastRotatedDate).Format(time.RFC3339))
+	} else {
+		d.Set("last_rotated_date", nil)
+	}
+
 	if aws.BoolValue(output.RotationEnabled) {
 		d.Set("rotation_lambda_arn", output.RotationLambdaARN)
 		if err := d.Set("rotation_rules", flattenSecretsManagerRotationRules(output.RotationRules)); err != nil {
@@ -164,6 +194,7 @@ func resourceSecretRotationUpdate(d *schema.ResourceData, meta interface{}) erro
 	if d.HasChanges("rotation_lambda_arn", "rotation_rules") {
 		if v, ok := d.GetOk("rotation_lambda_arn"); ok && v.(string) != "" {
 			input := &secretsmanager.RotateSecretInput{
+				RotateImmediately: aws.Bool(d.Get("rotate_immediately").(bool)),
 				RotationLambdaARN: aws.String(v.(string)),
 				RotationRules:     expandSecretsManagerRotationRules(d.Get("rotation_rules").([]interface{})),
 				SecretId:          aws.String(secretID),
@@ -223,14 +254,24 @@ func resourceSecretRotationDelete(d *schema.ResourceData, meta interface{}) erro
 }
 
 func expandSecretsManagerRotationRules(l []interface{}) *secretsmanager.RotationRulesType {
-	if len(l) == 0 {
+	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
 
 	m := l[0].(map[string]interface{})
 
-	rules := &secretsmanager.RotationRulesType{
-		AutomaticallyAfterDays: aws.Int64(int64(m["automatically_after_days"].(int))),
+	rules := &secretsmanager.RotationRulesType{}
+
+	if v, ok := m["automatically_after_days"].(int); ok && v != 0 {
+		rules.AutomaticallyAfterDays = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["duration"].(string); ok && v != "" {
+		rules.Duration = aws.String(v)
+	}
+
+	if v, ok := m["schedule_expression"].(string); ok && v != "" {
+		rules.ScheduleExpression = aws.String(v)
 	}
 
 	return rules
@@ -242,7 +283,13 @@ func flattenSecretsManagerRotationRules(rules *secretsmanager.RotationRulesType)
 	}
 
 	m := map[string]interface{}{
-		"automatically_after_days": int(aws.Int64Value(rules.AutomaticallyAfterDays)),
+		"duration":            aws.StringValue(rules.Duration),
+		"schedule_expression": aws.StringValue(rules.ScheduleExpression),
+	}
+
+	// AutomaticallyAfterDays is also returned when a schedule expression is used.
+	if rules.ScheduleExpression == nil {
+		m["automatically_after_days"] = int(aws.Int64Value(rules.AutomaticallyAfterDays))
 	}
 
 	return []interface{}{m}
@@ -2,6 +2,7 @@ package secretsmanager_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -60,6 +61,44 @@ func TestAccSecretsManagerSecretRotation_basic(t *testing.T) {
 	})
 }
 
+func TestAccSecretsManagerSecretRotation_scheduleExpression(t *testing.T) {
+	var secret secretsmanager.DescribeSecretOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_rotation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, secretsmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSecretRotationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSecretRotationScheduleExpressionConfig(rName, "rate(10 minutes)"),
+				ExpectError: regexp.MustCompile(`must be a rate\(...\) or cron\(...\) expression`),
+			},
+			{
+				Config: testAccSecretRotationScheduleExpressionConfig(rName, "rate(10 days)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretRotationExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "rotate_immediately", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.automatically_after_days", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.duration", "3h"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_rules.0.schedule_expression", "rate(10 days)"),
+					resource.TestCheckResourceAttr(resourceName, "last_rotated_date", ""),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotate_immediately"},
+			},
+		},
+	})
+}
+
 func testAccCheckSecretRotationDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerConn
 
@@ -171,3 +210,40 @@ resource "aws_secretsmanager_secret_rotation" "test" {
 }
 `, rName, automaticallyAfterDays)
 }
+
+func testAccSecretRotationScheduleExpressionConfig(rName, scheduleExpression string) string {
+	return acctest.ConfigLambdaBase(rName, rName, rName) + fmt.Sprintf(`
+# Not a real rotation function
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  handler       = "exports.example"
+  role          = aws_iam_role.iam_for_lambda.arn
+  runtime       = "nodejs12.x"
+}
+
+resource "aws_lambda_permission" "test" {
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "secretsmanager.amazonaws.com"
+  statement_id  = "AllowExecutionFromSecretsManager"
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_rotation" "test" {
+  secret_id           = aws_secretsmanager_secret.test.id
+  rotation_lambda_arn = aws_lambda_function.test.arn
+  rotate_immediately  = false
+
+  rotation_rules {
+    schedule_expression = %[2]q
+    duration            = "3h"
+  }
+
+  depends_on = [aws_lambda_permission.test]
+}
+`, rName, scheduleExpression)
+}
@@ -168,7 +168,7 @@ func resourceSecretVersionRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("secret_id", secretID)
 	d.Set("secret_string", output.SecretString)
-	d.Set("secret_binary", verify.Base64Encode(output.SecretBinary))
+	d.Set("secret_binary", base64.StdEncoding.EncodeToString(output.SecretBinary))
 	d.Set("version_id", output.VersionId)
 	d.Set("arn", output.ARN)
 
@@ -1,6 +1,7 @@
 package secretsmanager_test
 
 import (
+	"encoding/base64"
 	"fmt"
 	"testing"
 
@@ -80,6 +81,39 @@ func TestAccSecretsManagerSecretVersion_base64Binary(t *testing.T) {
 	})
 }
 
+// The decoded payload is itself valid base64 and must not be mistaken for
+// an already encoded value on read.
+func TestAccSecretsManagerSecretVersion_base64BinaryEncodedPayload(t *testing.T) {
+	var version secretsmanager.GetSecretValueOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, secretsmanager.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckSecretVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretVersionConfig_SecretBinaryPayload(rName, "dGVzdA=="),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretVersionExists(resourceName, &version),
+					resource.TestCheckResourceAttr(resourceName, "secret_binary", base64.StdEncoding.EncodeToString([]byte("dGVzdA=="))),
+				),
+			},
+			{
+				Config:   testAccSecretVersionConfig_SecretBinaryPayload(rName, "dGVzdA=="),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccSecretsManagerSecretVersion_versionStages(t *testing.T) {
 	var version secretsmanager.GetSecretValueOutput
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -241,6 +275,19 @@ resource "aws_secretsmanager_secret_version" "test" {
 `, rName)
 }
 
+func testAccSecretVersionConfig_SecretBinaryPayload(rName, payload string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_binary = base64encode(%[2]q)
+}
+`, rName, payload)
+}
+
 func testAccSecretVersionConfig_VersionStages_Single(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_secretsmanager_secret" "test" {
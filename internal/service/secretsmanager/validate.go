@@ -33,3 +33,27 @@ func validSecretNamePrefix(v interface{}, k string) (ws []string, errors []error
 	}
 	return
 }
+
+func validSecretRotationScheduleExpression(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	rate := regexp.MustCompile(`^rate\([1-9][0-9]* (hours?|days?)\)$`)
+	cron := regexp.MustCompile(`^cron\((\S+ ){5}\S+\)$`)
+	if !rate.MatchString(value) && !cron.MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a rate(...) or cron(...) expression, got: %q", k, value))
+	}
+	if len(value) > 256 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be greater than 256 characters", k))
+	}
+	return
+}
+
+func validSecretRotationDuration(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^([2-9]|1[0-9]|2[0-4])h$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a number of hours between 2h and 24h, got: %q", k, value))
+	}
+	return
+}
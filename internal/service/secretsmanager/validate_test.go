@@ -57,3 +57,83 @@ func TestValidSecretNamePrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidSecretRotationScheduleExpression(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "rate(10 days)",
+			ErrCount: 0,
+		},
+		{
+			Value:    "rate(1 day)",
+			ErrCount: 0,
+		},
+		{
+			Value:    "rate(4 hours)",
+			ErrCount: 0,
+		},
+		{
+			Value:    "cron(0 16 1,15 * ? *)",
+			ErrCount: 0,
+		},
+		{
+			Value:    "rate(0 days)",
+			ErrCount: 1,
+		},
+		{
+			Value:    "rate(10 minutes)",
+			ErrCount: 1,
+		},
+		{
+			Value:    "cron(0 16 1,15 * ?)",
+			ErrCount: 1,
+		},
+		{
+			Value:    "every 10 days",
+			ErrCount: 1,
+		},
+	}
+	for _, tc := range cases {
+		_, errors := validSecretRotationScheduleExpression(tc.Value, "schedule_expression")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestValidSecretRotationDuration(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "2h",
+			ErrCount: 0,
+		},
+		{
+			Value:    "24h",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1h",
+			ErrCount: 1,
+		},
+		{
+			Value:    "25h",
+			ErrCount: 1,
+		},
+		{
+			Value:    "3d",
+			ErrCount: 1,
+		},
+	}
+	for _, tc := range cases {
+		_, errors := validSecretRotationDuration(tc.Value, "duration")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
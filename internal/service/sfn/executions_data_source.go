@@ -0,0 +1,101 @@
+package sfn
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceExecutions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceExecutionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"state_machine_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status_filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(sfn.ExecutionStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceExecutionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SFNConn
+
+	stateMachineARN := d.Get("state_machine_arn").(string)
+	input := &sfn.ListExecutionsInput{
+		StateMachineArn: aws.String(stateMachineARN),
+	}
+
+	if v, ok := d.GetOk("status_filter"); ok {
+		input.StatusFilter = aws.String(v.(string))
+	}
+
+	maxResults := d.Get("max_results").(int)
+
+	// ListExecutions returns at most 1000 executions per page.
+	input.MaxResults = aws.Int64(int64(maxResults))
+	if maxResults > 1000 {
+		input.MaxResults = aws.Int64(1000)
+	}
+
+	var arns, names []string
+
+	err := conn.ListExecutionsPages(input, func(page *sfn.ListExecutionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Executions {
+			if v == nil {
+				continue
+			}
+
+			arns = append(arns, aws.StringValue(v.ExecutionArn))
+			names = append(names, aws.StringValue(v.Name))
+
+			if len(arns) >= maxResults {
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Step Function State Machine (%s) Executions: %w", stateMachineARN, err)
+	}
+
+	d.SetId(stateMachineARN)
+	d.Set("arns", arns)
+	d.Set("names", names)
+
+	return nil
+}
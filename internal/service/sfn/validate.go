@@ -1,10 +1,22 @@
 package sfn
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"sort"
 )
 
+// https://states-language.net/spec.html#toplevelfields.
+var stateMachineDefinitionTopLevelFields = map[string]bool{
+	"Comment":        true,
+	"QueryLanguage":  true,
+	"StartAt":        true,
+	"States":         true,
+	"TimeoutSeconds": true,
+	"Version":        true,
+}
+
 func validStateMachineName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if len(value) > 80 {
@@ -17,3 +29,37 @@ func validStateMachineName(v interface{}, k string) (ws []string, errors []error
 	}
 	return
 }
+
+// validStateMachineDefinition performs a lightweight check of an Amazon States Language
+// definition, catching malformed JSON and missing required top-level fields before the API is
+// called. Unknown top-level fields only produce a warning.
+func validStateMachineDefinition(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	var definition map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &definition); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON object: %w", k, err))
+		return
+	}
+
+	var unknown []string
+	for field := range definition {
+		if !stateMachineDefinitionTopLevelFields[field] {
+			unknown = append(unknown, field)
+		}
+	}
+	sort.Strings(unknown)
+
+	// The service may accept top-level fields added after this list was written, so only warn.
+	for _, field := range unknown {
+		ws = append(ws, fmt.Sprintf("%q contains an unknown top-level field: %q", k, field))
+	}
+
+	for _, field := range []string{"StartAt", "States"} {
+		if _, ok := definition[field]; !ok {
+			errors = append(errors, fmt.Errorf("%q is missing the required top-level field: %q", k, field))
+		}
+	}
+
+	return
+}
@@ -49,3 +49,48 @@ func TestValidStateMachineName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidStateMachineDefinition(t *testing.T) {
+	validDefinitions := []string{
+		`{"StartAt": "Pass", "States": {"Pass": {"Type": "Pass", "End": true}}}`,
+		`{"Comment": "A Hello World example", "Version": "1.0", "TimeoutSeconds": 60, "StartAt": "Pass", "States": {"Pass": {"Type": "Pass", "End": true}}}`,
+		`{"QueryLanguage": "JSONata", "StartAt": "Pass", "States": {"Pass": {"Type": "Pass", "End": true}}}`,
+	}
+
+	warningDefinitions := []string{
+		`{"StartAt": "Pass", "States": {"Pass": {"Type": "Pass", "End": true}}, "Timeout": 60}`,
+	}
+
+	invalidDefinitions := []string{
+		``,
+		`not json`,
+		`["StartAt", "States"]`,
+		`{"States": {"Pass": {"Type": "Pass", "End": true}}}`,
+		`{"StartAt": "Pass"}`,
+		`{"startAt": "Pass", "states": {"Pass": {"Type": "Pass", "End": true}}}`,
+	}
+
+	for _, v := range validDefinitions {
+		_, errors := validStateMachineDefinition(v, "definition")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Step Function State Machine definition: %v", v, errors)
+		}
+	}
+
+	for _, v := range warningDefinitions {
+		warnings, errors := validStateMachineDefinition(v, "definition")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Step Function State Machine definition: %v", v, errors)
+		}
+		if len(warnings) == 0 {
+			t.Fatalf("%q should produce a warning for an unknown top-level field", v)
+		}
+	}
+
+	for _, v := range invalidDefinitions {
+		_, errors := validStateMachineDefinition(v, "definition")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid Step Function State Machine definition", v)
+		}
+	}
+}
@@ -90,6 +90,52 @@ func ResourceAssociation() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 256),
 			},
+			"schedule_offset": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 6),
+			},
+			"sync_compliance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ssm.AssociationSyncCompliance_Values(), false),
+			},
+			"target_locations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accounts": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"execution_role_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"regions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"target_location_max_concurrency": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+						"target_location_max_errors": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+					},
+				},
+			},
 			"output_location": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -182,6 +228,14 @@ func resourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 		associationInput.ScheduleExpression = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("schedule_offset"); ok {
+		associationInput.ScheduleOffset = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("sync_compliance"); ok {
+		associationInput.SyncCompliance = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("parameters"); ok {
 		associationInput.Parameters = expandSSMDocumentParameters(v.(map[string]interface{}))
 	}
@@ -190,6 +244,12 @@ func resourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 		associationInput.Targets = expandTargets(v.([]interface{}))
 	}
 
+	// Omitted parameters are overwritten with null values, so leaving TargetLocations unset
+	// clears target locations removed from the configuration. An empty list fails validation.
+	if v, ok := d.GetOk("target_locations"); ok && len(v.([]interface{})) > 0 {
+		associationInput.TargetLocations = expandTargetLocations(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("output_location"); ok {
 		associationInput.OutputLocation = expandSSMAssociationOutputLocation(v.([]interface{}))
 	}
@@ -261,6 +321,8 @@ func resourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", association.Name)
 	d.Set("association_id", association.AssociationId)
 	d.Set("schedule_expression", association.ScheduleExpression)
+	d.Set("schedule_offset", association.ScheduleOffset)
+	d.Set("sync_compliance", association.SyncCompliance)
 	d.Set("document_version", association.DocumentVersion)
 	d.Set("compliance_severity", association.ComplianceSeverity)
 	d.Set("max_concurrency", association.MaxConcurrency)
@@ -275,6 +337,10 @@ func resourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting targets error: %w", err)
 	}
 
+	if err := d.Set("target_locations", flattenTargetLocations(association.TargetLocations)); err != nil {
+		return fmt.Errorf("error setting target_locations: %w", err)
+	}
+
 	if err := d.Set("output_location", flattenAssociationOutputLocation(association.OutputLocation)); err != nil {
 		return fmt.Errorf("Error setting output_location error: %w", err)
 	}
@@ -312,10 +378,24 @@ func resourceAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
 		associationInput.Parameters = expandSSMDocumentParameters(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("schedule_offset"); ok {
+		associationInput.ScheduleOffset = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("sync_compliance"); ok {
+		associationInput.SyncCompliance = aws.String(v.(string))
+	}
+
 	if _, ok := d.GetOk("targets"); ok {
 		associationInput.Targets = expandTargets(d.Get("targets").([]interface{}))
 	}
 
+	// Omitted parameters are overwritten with null values, so leaving TargetLocations unset
+	// clears target locations removed from the configuration. An empty list fails validation.
+	if v, ok := d.GetOk("target_locations"); ok && len(v.([]interface{})) > 0 {
+		associationInput.TargetLocations = expandTargetLocations(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("output_location"); ok {
 		associationInput.OutputLocation = expandSSMAssociationOutputLocation(v.([]interface{}))
 	}
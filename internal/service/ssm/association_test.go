@@ -556,6 +556,41 @@ func TestAccSSMAssociation_withComplianceSeverity(t *testing.T) {
 	})
 }
 
+func TestAccSSMAssociation_scheduleOffsetAndSyncCompliance(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationScheduleOffsetAndSyncComplianceConfig(rName, 2, ssm.AssociationSyncComplianceManual),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule_offset", "2"),
+					resource.TestCheckResourceAttr(resourceName, "sync_compliance", ssm.AssociationSyncComplianceManual),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationScheduleOffsetAndSyncComplianceConfig(rName, 3, ssm.AssociationSyncComplianceAuto),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule_offset", "3"),
+					resource.TestCheckResourceAttr(resourceName, "sync_compliance", ssm.AssociationSyncComplianceAuto),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMAssociation_rateControl(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ssm_association.test"
@@ -591,6 +626,51 @@ func TestAccSSMAssociation_rateControl(t *testing.T) {
 	})
 }
 
+func TestAccSSMAssociation_targetLocations(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationNoTargetLocationsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.#", "0"),
+				),
+			},
+			{
+				Config: testAccAssociationTargetLocationsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.accounts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.regions.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_locations.0.execution_role_name", "aws_iam_role.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.target_location_max_concurrency", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.target_location_max_errors", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssociationNoTargetLocationsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAssociationExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1512,6 +1592,139 @@ resource "aws_ssm_association" "test" {
 `, rName, assocName, compSeverity)
 }
 
+func testAccAssociationScheduleOffsetAndSyncComplianceConfig(rName string, scheduleOffset int, syncCompliance string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Command"
+
+  content = <<DOC
+{
+  "schemaVersion": "1.2",
+  "description": "Check ip configuration of a Linux instance.",
+  "parameters": {},
+  "runtimeConfig": {
+    "aws:runShellScript": {
+      "properties": [
+        {
+          "id": "0.aws:runShellScript",
+          "runCommand": [
+            "ifconfig"
+          ]
+        }
+      ]
+    }
+  }
+}
+DOC
+
+}
+
+resource "aws_ssm_association" "test" {
+  name                = aws_ssm_document.test.name
+  schedule_expression = "cron(0 16 ? * TUE *)"
+  schedule_offset     = %[2]d
+  sync_compliance     = %[3]q
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+}
+`, rName, scheduleOffset, syncCompliance)
+}
+
+func testAccAssociationTargetLocationsConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "ssm.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_ssm_document" "test" {
+  name          = %[1]q
+  document_type = "Automation"
+
+  content = <<DOC
+{
+  "schemaVersion": "0.3",
+  "description": "Sleep for a short time.",
+  "parameters": {
+    "InstanceId": {
+      "type": "String"
+    }
+  },
+  "mainSteps": [
+    {
+      "name": "sleep",
+      "action": "aws:sleep",
+      "inputs": {
+        "Duration": "PT1S"
+      }
+    }
+  ]
+}
+DOC
+}
+`, rName)
+}
+
+func testAccAssociationNoTargetLocationsConfig(rName string) string {
+	return acctest.ConfigCompose(testAccAssociationTargetLocationsConfigBase(rName), `
+resource "aws_ssm_association" "test" {
+  name                             = aws_ssm_document.test.name
+  automation_target_parameter_name = "InstanceId"
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+}
+`)
+}
+
+func testAccAssociationTargetLocationsConfig(rName string) string {
+	return acctest.ConfigCompose(testAccAssociationTargetLocationsConfigBase(rName), `
+resource "aws_ssm_association" "test" {
+  name                             = aws_ssm_document.test.name
+  automation_target_parameter_name = "InstanceId"
+
+  targets {
+    key    = "tag:Name"
+    values = ["acceptanceTest"]
+  }
+
+  target_locations {
+    accounts                        = [data.aws_caller_identity.current.account_id]
+    execution_role_name             = aws_iam_role.test.name
+    regions                         = [data.aws_region.current.name]
+    target_location_max_concurrency = "1"
+    target_location_max_errors      = "1"
+  }
+}
+`)
+}
+
 func testAccAssociationRateControlConfig(rName, rate string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_document" "test" {
@@ -1,6 +1,7 @@
 package ssm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -103,6 +105,7 @@ func ResourceDocument() *schema.Resource {
 			},
 			"default_version": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
 			},
 			"description": {
@@ -189,8 +192,55 @@ func ResourceDocument() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceDocumentCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceDocumentCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		// A new document only has version 1.
+		if v := diff.GetRawConfig().GetAttr("default_version"); v.IsKnown() && !v.IsNull() && v.AsString() != "1" {
+			return fmt.Errorf("default_version must be \"1\" when creating a document, got %q", v.AsString())
+		}
+
+		return nil
+	}
+
+	// Any of these changes creates a new document version.
+	if diff.HasChanges("attachments_source", "content", "document_format", "target_type", "version_name") {
+		if err := diff.SetNewComputed("latest_version"); err != nil {
+			return err
+		}
+
+		// Without a pinned default version, the new version becomes the default.
+		if v := diff.GetRawConfig().GetAttr("default_version"); v.IsKnown() && v.IsNull() {
+			for _, k := range []string{"default_version", "document_version", "hash"} {
+				if err := diff.SetNewComputed(k); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// Removing a pinned default version makes the latest version the default again.
+	if v := diff.GetRawConfig().GetAttr("default_version"); v.IsKnown() && v.IsNull() && diff.Get("default_version").(string) != diff.Get("latest_version").(string) {
+		if err := diff.SetNewComputed("default_version"); err != nil {
+			return err
+		}
+	}
+
+	if diff.HasChange("default_version") {
+		for _, k := range []string{"document_version", "hash"} {
+			if err := diff.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
 	}
+
+	return nil
 }
 
 func resourceDocumentCreate(d *schema.ResourceData, meta interface{}) error {
@@ -409,7 +459,19 @@ func resourceDocumentUpdate(d *schema.ResourceData, meta interface{}) error {
 	isSchemaVersion1, _ := regexp.MatchString("^1[.][0-9]$", d.Get("schema_version").(string))
 
 	if !d.HasChange("content") && isSchemaVersion1 {
-		return nil
+		if d.HasChange("default_version") {
+			defaultVersion := d.Get("latest_version").(string)
+
+			if v := d.GetRawConfig().GetAttr("default_version"); v.IsKnown() && !v.IsNull() {
+				defaultVersion = v.AsString()
+			}
+
+			if err := updateDocumentDefaultVersion(conn, d.Get("name").(string), defaultVersion); err != nil {
+				return err
+			}
+		}
+
+		return resourceDocumentRead(d, meta)
 	}
 
 	if d.HasChangesExcept("tags", "tags_all", "permissions") {
@@ -486,17 +548,21 @@ func setDocumentPermissions(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Setting permissions for document: %s", d.Id())
 
 	if d.HasChange("permissions") {
-		o, n := d.GetChange("permissions")
-		oldPermissions := o.(map[string]interface{})
-		newPermissions := n.(map[string]interface{})
-		oldPermissionsAccountIds := make([]interface{}, 0)
-		if v, ok := oldPermissions["account_ids"]; ok && v.(string) != "" {
-			parts := strings.Split(v.(string), ",")
-			oldPermissionsAccountIds = make([]interface{}, len(parts))
-			for i, v := range parts {
-				oldPermissionsAccountIds[i] = v
-			}
+		newPermissions := d.Get("permissions").(map[string]interface{})
+
+		// Reconcile against the accounts the document is actually shared with
+		// so that sharing added outside of Terraform is removed as well.
+		accountIds, err := FindDocumentShareAccountIDs(conn, d.Get("name").(string))
+
+		if err != nil {
+			return fmt.Errorf("error reading SSM Document (%s) permissions: %w", d.Id(), err)
+		}
+
+		oldPermissionsAccountIds := make([]interface{}, len(accountIds))
+		for i, v := range accountIds {
+			oldPermissionsAccountIds[i] = v
 		}
+
 		newPermissionsAccountIds := make([]interface{}, 0)
 		if v, ok := newPermissions["account_ids"]; ok && v.(string) != "" {
 			parts := strings.Split(v.(string), ",")
@@ -538,19 +604,13 @@ func getDocumentPermissions(d *schema.ResourceData, meta interface{}) (map[strin
 	//How to get from nested scheme resource?
 	permissionType := "Share"
 
-	permInput := &ssm.DescribeDocumentPermissionInput{
-		Name:           aws.String(d.Get("name").(string)),
-		PermissionType: aws.String(permissionType),
-	}
-
-	resp, err := conn.DescribeDocumentPermission(permInput)
+	accountIds, err := FindDocumentShareAccountIDs(conn, d.Get("name").(string))
 
 	if err != nil {
 		return nil, fmt.Errorf("Error setting permissions for SSM document: %s", err)
 	}
 
 	ids := ""
-	accountIds := aws.StringValueSlice(resp.AccountIds)
 
 	if len(accountIds) == 1 {
 		ids = accountIds[0]
@@ -574,24 +634,23 @@ func deleteDocumentPermissions(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] Removing permissions from document: %s", d.Id())
 
-	permission := d.Get("permissions").(map[string]interface{})
+	accountIds, err := FindDocumentShareAccountIDs(conn, d.Get("name").(string))
 
-	accountIdsToRemove := make([]interface{}, 0)
-
-	if permission["account_ids"] != nil {
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeInvalidDocument) {
+		return nil
+	}
 
-		if v, ok := permission["account_ids"]; ok && v.(string) != "" {
-			parts := strings.Split(v.(string), ",")
-			accountIdsToRemove = make([]interface{}, len(parts))
-			for i, v := range parts {
-				accountIdsToRemove[i] = v
-			}
-		}
+	if err != nil {
+		return fmt.Errorf("error reading SSM Document (%s) permissions: %w", d.Id(), err)
+	}
 
-		if err := modifyDocumentPermissions(conn, d.Get("name").(string), nil, accountIdsToRemove); err != nil {
-			return fmt.Errorf("error removing SSM document permissions: %s", err)
-		}
+	accountIdsToRemove := make([]interface{}, len(accountIds))
+	for i, v := range accountIds {
+		accountIdsToRemove[i] = v
+	}
 
+	if err := modifyDocumentPermissions(conn, d.Get("name").(string), nil, accountIdsToRemove); err != nil {
+		return fmt.Errorf("error removing SSM document permissions: %s", err)
 	}
 
 	return nil
@@ -599,7 +658,7 @@ func deleteDocumentPermissions(d *schema.ResourceData, meta interface{}) error {
 
 func modifyDocumentPermissions(conn *ssm.SSM, name string, accountIdsToAdd []interface{}, accountIdstoRemove []interface{}) error {
 
-	if accountIdsToAdd != nil {
+	if len(accountIdsToAdd) > 0 {
 
 		accountIdsToAddBatch := make([]string, 0, SSM_DOCUMENT_PERMISSIONS_BATCH_LIMIT)
 		accountIdsToAddBatches := make([][]string, 0, len(accountIdsToAdd)/SSM_DOCUMENT_PERMISSIONS_BATCH_LIMIT+1)
@@ -624,7 +683,7 @@ func modifyDocumentPermissions(conn *ssm.SSM, name string, accountIdsToAdd []int
 		}
 	}
 
-	if accountIdstoRemove != nil {
+	if len(accountIdstoRemove) > 0 {
 
 		accountIdsToRemoveBatch := make([]string, 0, SSM_DOCUMENT_PERMISSIONS_BATCH_LIMIT)
 		accountIdsToRemoveBatches := make([][]string, 0, len(accountIdstoRemove)/SSM_DOCUMENT_PERMISSIONS_BATCH_LIMIT+1)
@@ -654,52 +713,65 @@ func modifyDocumentPermissions(conn *ssm.SSM, name string, accountIdsToAdd []int
 }
 
 func updateDocument(d *schema.ResourceData, meta interface{}) error {
-	log.Printf("[INFO] Updating SSM Document: %s", d.Id())
-
+	conn := meta.(*conns.AWSClient).SSMConn
 	name := d.Get("name").(string)
+	newDefaultVersion := d.Get("latest_version").(string)
 
-	updateDocInput := &ssm.UpdateDocumentInput{
-		Name:            aws.String(name),
-		Content:         aws.String(d.Get("content").(string)),
-		DocumentFormat:  aws.String(d.Get("document_format").(string)),
-		DocumentVersion: aws.String(d.Get("default_version").(string)),
-	}
+	if d.HasChangesExcept("default_version", "permissions", "tags", "tags_all") {
+		log.Printf("[INFO] Updating SSM Document: %s", d.Id())
 
-	if v, ok := d.GetOk("target_type"); ok {
-		updateDocInput.TargetType = aws.String(v.(string))
-	}
+		// Only the latest version of a document can be updated.
+		updateDocInput := &ssm.UpdateDocumentInput{
+			Name:            aws.String(name),
+			Content:         aws.String(d.Get("content").(string)),
+			DocumentFormat:  aws.String(d.Get("document_format").(string)),
+			DocumentVersion: aws.String(d.Get("latest_version").(string)),
+		}
 
-	if v, ok := d.GetOk("version_name"); ok {
-		updateDocInput.VersionName = aws.String(v.(string))
-	}
+		if v, ok := d.GetOk("target_type"); ok {
+			updateDocInput.TargetType = aws.String(v.(string))
+		}
 
-	if d.HasChange("attachments_source") {
-		updateDocInput.Attachments = expandSsmAttachmentsSources(d.Get("attachments_source").([]interface{}))
-	}
+		if v, ok := d.GetOk("version_name"); ok {
+			updateDocInput.VersionName = aws.String(v.(string))
+		}
+
+		if d.HasChange("attachments_source") {
+			updateDocInput.Attachments = expandSsmAttachmentsSources(d.Get("attachments_source").([]interface{}))
+		}
 
-	newDefaultVersion := d.Get("default_version").(string)
+		updated, err := conn.UpdateDocument(updateDocInput)
 
-	conn := meta.(*conns.AWSClient).SSMConn
-	updated, err := conn.UpdateDocument(updateDocInput)
+		if tfawserr.ErrCodeEquals(err, ssm.ErrCodeDuplicateDocumentContent) {
+			log.Printf("[DEBUG] Content is a duplicate of the latest version so update is not necessary: %s", d.Id())
+		} else if err != nil {
+			return fmt.Errorf("Error updating SSM document: %s", err)
+		} else {
+			newDefaultVersion = aws.StringValue(updated.DocumentDescription.DocumentVersion)
+		}
+	}
 
-	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeDuplicateDocumentContent) {
-		log.Printf("[DEBUG] Content is a duplicate of the latest version so update is not necessary: %s", d.Id())
-		log.Printf("[INFO] Updating the default version to the latest version %s: %s", newDefaultVersion, d.Id())
+	// A configured default version is pinned, otherwise the latest version becomes the default.
+	if v := d.GetRawConfig().GetAttr("default_version"); v.IsKnown() && !v.IsNull() {
+		newDefaultVersion = v.AsString()
+	}
 
-		newDefaultVersion = d.Get("latest_version").(string)
-	} else if err != nil {
-		return fmt.Errorf("Error updating SSM document: %s", err)
-	} else {
-		log.Printf("[INFO] Updating the default version to the new version %s: %s", newDefaultVersion, d.Id())
-		newDefaultVersion = aws.StringValue(updated.DocumentDescription.DocumentVersion)
+	if o, _ := d.GetChange("default_version"); o.(string) == newDefaultVersion {
+		return nil
 	}
 
+	return updateDocumentDefaultVersion(conn, name, newDefaultVersion)
+}
+
+func updateDocumentDefaultVersion(conn *ssm.SSM, name, version string) error {
+	log.Printf("[INFO] Updating the default version to %s: %s", version, name)
+
 	updateDefaultInput := &ssm.UpdateDocumentDefaultVersionInput{
 		Name:            aws.String(name),
-		DocumentVersion: aws.String(newDefaultVersion),
+		DocumentVersion: aws.String(version),
 	}
 
-	_, err = conn.UpdateDocumentDefaultVersion(updateDefaultInput)
+	_, err := conn.UpdateDocumentDefaultVersion(updateDefaultInput)
 
 	if err != nil {
 		return fmt.Errorf("Error updating the default document version to that of the updated document: %s", err)
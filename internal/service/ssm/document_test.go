@@ -2,6 +2,7 @@ package ssm_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -178,6 +179,134 @@ func TestAccSSMDocument_update(t *testing.T) {
 	})
 }
 
+func TestAccSSMDocument_defaultVersion(t *testing.T) {
+	name := sdkacctest.RandString(10)
+	resourceName := "aws_ssm_document.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDocumentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentDefaultVersionConfig(name, "Get-Process", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "document_version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "hash"),
+				),
+			},
+			{
+				Config: testAccDocumentDefaultVersionConfig(name, "Get-Process -Verbose", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "document_version", "2"),
+				),
+			},
+			{
+				Config: testAccDocumentDefaultVersionConfig(name, "Get-Process -Verbose", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "document_version", "1"),
+				),
+			},
+			{
+				Config: testAccDocumentDefaultVersionConfig(name, "Get-Service", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "3"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "document_version", "1"),
+				),
+			},
+			{
+				Config: testAccDocumentDefaultVersionConfig(name, "Get-Service", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "3"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "3"),
+					resource.TestCheckResourceAttr(resourceName, "document_version", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMDocument_DefaultVersion_schemaVersion1(t *testing.T) {
+	name := sdkacctest.RandString(10)
+	resourceName := "aws_ssm_document.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDocumentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentDefaultVersionSchemaVersion1Config(name, "ls", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schema_version", "1.2"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+				),
+			},
+			{
+				Config: testAccDocumentDefaultVersionSchemaVersion1Config(name, "ls -la", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "2"),
+				),
+			},
+			{
+				Config: testAccDocumentDefaultVersionSchemaVersion1Config(name, "ls -la", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "document_version", "1"),
+				),
+			},
+			{
+				Config: testAccDocumentDefaultVersionSchemaVersion1Config(name, "ls -la", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "document_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMDocument_DefaultVersion_create(t *testing.T) {
+	name := sdkacctest.RandString(10)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDocumentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDocumentDefaultVersionConfig(name, "Get-Process", "2"),
+				ExpectError: regexp.MustCompile(`default_version must be "1" when creating a document`),
+			},
+		},
+	})
+}
+
 func TestAccSSMDocument_Permission_public(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
@@ -234,6 +363,7 @@ func TestAccSSMDocument_Permission_batching(t *testing.T) {
 	name := sdkacctest.RandString(10)
 	resourceName := "aws_ssm_document.test"
 	ids := "123456789012,123456789013,123456789014,123456789015,123456789016,123456789017,123456789018,123456789019,123456789020,123456789021,123456789022,123456789023,123456789024,123456789025,123456789026,123456789027,123456789028,123456789029,123456789030,123456789031,123456789032"
+	idsRemove := "123456789012"
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:     func() { acctest.PreCheck(t) },
 		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
@@ -252,6 +382,14 @@ func TestAccSSMDocument_Permission_batching(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccDocumentPrivatePermissionConfig(name, idsRemove),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permissions.type", "Share"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.account_ids", idsRemove),
+				),
+			},
 		},
 	})
 }
@@ -815,6 +953,79 @@ DOC
 `, rName)
 }
 
+func testAccDocumentDefaultVersionConfig(rName, command, defaultVersion string) string {
+	defaultVersionArgument := ""
+	if defaultVersion != "" {
+		defaultVersionArgument = fmt.Sprintf("default_version = %q", defaultVersion)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = "test_document-%[1]s"
+  document_type = "Command"
+
+  %[3]s
+
+  content = <<DOC
+{
+  "schemaVersion": "2.0",
+  "description": "Sample version 2.0 document",
+  "parameters": {},
+  "mainSteps": [
+    {
+      "action": "aws:runPowerShellScript",
+      "name": "runPowerShellScript",
+      "inputs": {
+        "runCommand": [
+          %[2]q
+        ]
+      }
+    }
+  ]
+}
+DOC
+
+}
+`, rName, command, defaultVersionArgument)
+}
+
+func testAccDocumentDefaultVersionSchemaVersion1Config(rName, command, defaultVersion string) string {
+	defaultVersionArgument := ""
+	if defaultVersion != "" {
+		defaultVersionArgument = fmt.Sprintf("default_version = %q", defaultVersion)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name          = "test_document-%[1]s"
+  document_type = "Command"
+
+  %[3]s
+
+  content = <<DOC
+{
+  "schemaVersion": "1.2",
+  "description": "Sample version 1.2 document",
+  "parameters": {},
+  "runtimeConfig": {
+    "aws:runShellScript": {
+      "properties": [
+        {
+          "id": "0.aws:runShellScript",
+          "runCommand": [
+            %[2]q
+          ]
+        }
+      ]
+    }
+  }
+}
+DOC
+
+}
+`, rName, command, defaultVersionArgument)
+}
+
 func testAccDocumentPublicPermissionConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_document" "test" {
@@ -58,6 +58,37 @@ func FindDocumentByName(conn *ssm.SSM, name string) (*ssm.DocumentDescription, e
 	return output.Document, nil
 }
 
+// FindDocumentShareAccountIDs returns the IDs of all accounts the specified Document is shared with.
+func FindDocumentShareAccountIDs(conn *ssm.SSM, name string) ([]string, error) {
+	input := &ssm.DescribeDocumentPermissionInput{
+		Name:           aws.String(name),
+		PermissionType: aws.String(ssm.DocumentPermissionTypeShare),
+	}
+	var accountIDs []string
+
+	for {
+		output, err := conn.DescribeDocumentPermission(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		accountIDs = append(accountIDs, aws.StringValueSlice(output.AccountIds)...)
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return accountIDs, nil
+}
+
 // FindPatchGroup returns matching SSM Patch Group by Patch Group and BaselineId.
 func FindPatchGroup(conn *ssm.SSM, patchGroup, baselineId string) (*ssm.PatchGroupPatchBaselineMapping, error) {
 	input := &ssm.DescribePatchGroupsInput{}
@@ -22,6 +22,10 @@ import (
 const (
 	// Maximum amount of time to wait for asynchronous validation on SSM Parameter creation.
 	ssmParameterCreationValidationTimeout = 2 * time.Minute
+
+	// Maximum parameter value sizes, in bytes.
+	parameterValueMaxSizeAdvanced = 8192
+	parameterValueMaxSizeStandard = 4096
 )
 
 func ResourceParameter() *schema.Resource {
@@ -88,6 +92,10 @@ func ResourceParameter() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"allowed_pattern": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -112,6 +120,10 @@ func ResourceParameter() *schema.Resource {
 			customdiff.ComputedIf("version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("value")
 			}),
+			customdiff.ComputedIf("last_modified_date", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("value")
+			}),
+			customizeDiffParameterValueSize,
 			verify.SetTagsDiff,
 		),
 	}
@@ -225,6 +237,11 @@ func resourceParameterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("type", param.Type)
 	d.Set("value", param.Value)
 	d.Set("version", param.Version)
+	if param.LastModifiedDate != nil {
+		d.Set("last_modified_date", aws.TimeValue(param.LastModifiedDate).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified_date", nil)
+	}
 
 	describeParamsInput := &ssm.DescribeParametersInput{
 		ParameterFilters: []*ssm.ParameterStringFilter{
@@ -291,6 +308,11 @@ func resourceParameterUpdate(d *schema.ResourceData, meta interface{}) error {
 			AllowedPattern: aws.String(d.Get("allowed_pattern").(string)),
 		}
 
+		// With Intelligent-Tiering configured, d.Get returns the concrete tier from state.
+		if v := d.GetRawConfig().GetAttr("tier"); v.IsKnown() && !v.IsNull() && v.AsString() == ssm.ParameterTierIntelligentTiering {
+			paramInput.Tier = aws.String(ssm.ParameterTierIntelligentTiering)
+		}
+
 		if d.HasChange("data_type") {
 			paramInput.DataType = aws.String(d.Get("data_type").(string))
 		}
@@ -344,6 +366,51 @@ func resourceParameterDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// customizeDiffParameterValueSize checks the parameter value against the size limit of the
+// configured tier. Intelligent-Tiering selects the tier based on the value, so is not checked.
+func customizeDiffParameterValueSize(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.NewValueKnown("value") {
+		return nil
+	}
+
+	// tier's DiffSuppressFunc keeps the concrete tier from state when Intelligent-Tiering is configured,
+	// so read the configured tier.
+	rawConfig := diff.GetRawConfig()
+
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	v := rawConfig.GetAttr("tier")
+
+	if !v.IsKnown() {
+		return nil
+	}
+
+	tier := ssm.ParameterTierStandard
+
+	if !v.IsNull() {
+		tier = v.AsString()
+	}
+
+	maxSize := 0
+
+	switch tier {
+	case ssm.ParameterTierStandard:
+		maxSize = parameterValueMaxSizeStandard
+	case ssm.ParameterTierAdvanced:
+		maxSize = parameterValueMaxSizeAdvanced
+	default:
+		return nil
+	}
+
+	if size := len(diff.Get("value").(string)); size > maxSize {
+		return fmt.Errorf("value is %d bytes, which exceeds the %d byte limit for the %s tier", size, maxSize, tier)
+	}
+
+	return nil
+}
+
 func ShouldUpdateParameter(d *schema.ResourceData) bool {
 	// If the user has specified a preference, return their preference
 	if value, ok := d.GetOkExists("overwrite"); ok {
@@ -2,6 +2,8 @@ package ssm_test
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -36,6 +38,7 @@ func TestAccSSMParameter_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tier", "Standard"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttrSet(resourceName, "version"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_date"),
 					resource.TestCheckResourceAttr(resourceName, "data_type", "text"),
 				),
 			},
@@ -91,6 +94,66 @@ func TestAccSSMParameter_tier(t *testing.T) {
 	})
 }
 
+func TestAccSSMParameter_Tier_valueSize(t *testing.T) {
+	var param ssm.Parameter
+	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	resourceName := "aws_ssm_parameter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccParameterTierValueConfig(rName, ssm.ParameterTierStandard, strings.Repeat("x", 4097)),
+				ExpectError: regexp.MustCompile(`exceeds the 4096 byte limit for the Standard tier`),
+			},
+			{
+				Config: testAccParameterTierValueConfig(rName, ssm.ParameterTierAdvanced, strings.Repeat("x", 4097)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierAdvanced),
+				),
+			},
+			{
+				Config:      testAccParameterTierValueConfig(rName, ssm.ParameterTierAdvanced, strings.Repeat("x", 8193)),
+				ExpectError: regexp.MustCompile(`exceeds the 8192 byte limit for the Advanced tier`),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameter_Tier_intelligentTieringValueSize(t *testing.T) {
+	var param ssm.Parameter
+	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
+	resourceName := "aws_ssm_parameter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterTierValueConfig(rName, ssm.ParameterTierIntelligentTiering, "x"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierStandard),
+				),
+			},
+			{
+				Config: testAccParameterTierValueConfig(rName, ssm.ParameterTierIntelligentTiering, strings.Repeat("x", 4097)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "tier", ssm.ParameterTierAdvanced),
+					resource.TestCheckResourceAttr(resourceName, "value", strings.Repeat("x", 4097)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMParameter_Tier_intelligentTieringToStandard(t *testing.T) {
 	var parameter ssm.Parameter
 	rName := fmt.Sprintf("%s_%s", t.Name(), sdkacctest.RandString(10))
@@ -770,6 +833,17 @@ resource "aws_ssm_parameter" "test" {
 `, rName, tier)
 }
 
+func testAccParameterTierValueConfig(rName, tier, value string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  tier  = %[2]q
+  type  = "String"
+  value = %[3]q
+}
+`, rName, tier, value)
+}
+
 func testAccParameterDataTypeEC2ImageConfig(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHvmEbsAmi(),
@@ -2,6 +2,9 @@ package ssm
 
 import (
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -27,7 +30,7 @@ func waitAssociationSuccess(conn *ssm.SSM, id string, timeout time.Duration) (*s
 
 	if output, ok := outputRaw.(*ssm.AssociationDescription); ok && output.Overview != nil {
 		if status := aws.StringValue(output.Overview.Status); status == ssm.AssociationStatusNameFailed {
-			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Overview.DetailedStatus)))
+			tfresource.SetLastError(err, errors.New(strings.TrimSpace(aws.StringValue(output.Overview.DetailedStatus)+formatAssociationStatusAggregatedCount(output.Overview.AssociationStatusAggregatedCount))))
 		}
 		return output, err
 	}
@@ -35,6 +38,26 @@ func waitAssociationSuccess(conn *ssm.SSM, id string, timeout time.Duration) (*s
 	return nil, err
 }
 
+// formatAssociationStatusAggregatedCount formats the per-status target counts of an association, e.g. " (Failed: 2, Success: 3)".
+func formatAssociationStatusAggregatedCount(counts map[string]*int64) string {
+	if len(counts) == 0 {
+		return ""
+	}
+
+	statuses := make([]string, 0, len(counts))
+	for status := range counts {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+
+	parts := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		parts = append(parts, fmt.Sprintf("%s: %d", status, aws.Int64Value(counts[status])))
+	}
+
+	return fmt.Sprintf(" (%s)", strings.Join(parts, ", "))
+}
+
 // waitDocumentDeleted waits for an Document to return Deleted
 func waitDocumentDeleted(conn *ssm.SSM, name string) (*ssm.DocumentDescription, error) {
 	stateConf := &resource.StateChangeConf{
@@ -1,6 +1,9 @@
 package wafv2
 
 import (
+	"net"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/wafv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -1103,3 +1106,27 @@ func flattenVisibilityConfig(config *wafv2.VisibilityConfig) interface{} {
 
 	return []interface{}{m}
 }
+
+func expandIPSetAddresses(tfSet *schema.Set) []*string {
+	apiObjects := make([]*string, 0, tfSet.Len())
+
+	for _, v := range tfSet.List() {
+		apiObjects = append(apiObjects, aws.String(normalizeIPSetAddress(v.(string))))
+	}
+
+	return apiObjects
+}
+
+// normalizeIPSetAddress appends a /128 prefix length to a bare IPv6 address,
+// as WAFv2 only accepts addresses in CIDR notation.
+func normalizeIPSetAddress(address string) string {
+	if strings.Contains(address, "/") {
+		return address
+	}
+
+	if ip := net.ParseIP(address); ip != nil && ip.To4() == nil {
+		return address + "/128"
+	}
+
+	return address
+}
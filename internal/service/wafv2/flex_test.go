@@ -0,0 +1,47 @@
+package wafv2
+
+import (
+	"testing"
+)
+
+func TestNormalizeIPSetAddress(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "1111:0000:0000:0000:0000:0000:0000:0111",
+			Expected: "1111:0000:0000:0000:0000:0000:0000:0111/128",
+		},
+		{
+			Input:    "2001:db8::1",
+			Expected: "2001:db8::1/128",
+		},
+		{
+			Input:    "2001:db8::/32",
+			Expected: "2001:db8::/32",
+		},
+		{
+			Input:    "2001:db8::1/128",
+			Expected: "2001:db8::1/128",
+		},
+		{
+			Input:    "192.0.2.44",
+			Expected: "192.0.2.44",
+		},
+		{
+			Input:    "192.0.2.44/32",
+			Expected: "192.0.2.44/32",
+		},
+		{
+			Input:    "not-an-address",
+			Expected: "not-an-address",
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := normalizeIPSetAddress(testCase.Input); got != testCase.Expected {
+			t.Errorf("normalizeIPSetAddress(%q) = %q, expected %q", testCase.Input, got, testCase.Expected)
+		}
+	}
+}
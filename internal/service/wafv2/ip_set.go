@@ -55,7 +55,7 @@ func ResourceIPSet() *schema.Resource {
 						for _, ov := range oldAddresses {
 							hasAddress := false
 							for _, nv := range newAddresses {
-								if verify.CIDRBlocksEqual(ov.(string), nv.(string)) {
+								if verify.CIDRBlocksEqual(normalizeIPSetAddress(ov.(string)), normalizeIPSetAddress(nv.(string))) {
 									hasAddress = true
 									break
 								}
@@ -126,7 +126,7 @@ func resourceIPSetCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if v, ok := d.GetOk("addresses"); ok && v.(*schema.Set).Len() > 0 {
-		params.Addresses = flex.ExpandStringSet(v.(*schema.Set))
+		params.Addresses = expandIPSetAddresses(v.(*schema.Set))
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -221,7 +221,7 @@ func resourceIPSetUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if v, ok := d.GetOk("addresses"); ok && v.(*schema.Set).Len() > 0 {
-		params.Addresses = flex.ExpandStringSet(v.(*schema.Set))
+		params.Addresses = expandIPSetAddresses(v.(*schema.Set))
 	}
 
 	if v, ok := d.GetOk("description"); ok {
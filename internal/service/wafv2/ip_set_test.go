@@ -123,6 +123,35 @@ func TestAccWAFV2IPSet_ipv6(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2IPSet_ipv6Normalized(t *testing.T) {
+	var v wafv2.IPSet
+	ipSetName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
+	resourceName := "aws_wafv2_ip_set.ip_set"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckScopeRegional(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, wafv2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckIPSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPSetIPv6NormalizedConfig(ipSetName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPSetExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "ip_address_version", wafv2.IPAddressVersionIpv6),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "addresses.*", "2001:db8::1/128"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "addresses.*", "2001:db8::2/128"),
+				),
+			},
+			{
+				Config:   testAccIPSetIPv6NormalizedConfig(ipSetName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccWAFV2IPSet_minimal(t *testing.T) {
 	var v wafv2.IPSet
 	ipSetName := fmt.Sprintf("ip-set-%s", sdkacctest.RandString(5))
@@ -393,6 +422,21 @@ resource "aws_wafv2_ip_set" "ip_set" {
 `, name, name)
 }
 
+func testAccIPSetIPv6NormalizedConfig(name string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_ip_set" "ip_set" {
+  name               = "%s"
+  description        = "%s"
+  scope              = "REGIONAL"
+  ip_address_version = "IPV6"
+  addresses = [
+    "2001:db8::1",
+    "2001:db8::2/128"
+  ]
+}
+`, name, name)
+}
+
 func testAccIPSetMinimalConfig(name string) string {
 	return fmt.Sprintf(`
 resource "aws_wafv2_ip_set" "ip_set" {
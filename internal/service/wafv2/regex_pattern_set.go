@@ -183,28 +183,30 @@ func resourceRegexPatternSetRead(d *schema.ResourceData, meta interface{}) error
 func resourceRegexPatternSetUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).WAFV2Conn
 
-	log.Printf("[INFO] Updating WAFv2 RegexPatternSet %s", d.Id())
-
-	params := &wafv2.UpdateRegexPatternSetInput{
-		Id:                    aws.String(d.Id()),
-		Name:                  aws.String(d.Get("name").(string)),
-		Scope:                 aws.String(d.Get("scope").(string)),
-		LockToken:             aws.String(d.Get("lock_token").(string)),
-		RegularExpressionList: []*wafv2.Regex{},
-	}
+	if d.HasChangesExcept("tags", "tags_all") {
+		log.Printf("[INFO] Updating WAFv2 RegexPatternSet %s", d.Id())
+
+		params := &wafv2.UpdateRegexPatternSetInput{
+			Id:                    aws.String(d.Id()),
+			Name:                  aws.String(d.Get("name").(string)),
+			Scope:                 aws.String(d.Get("scope").(string)),
+			LockToken:             aws.String(d.Get("lock_token").(string)),
+			RegularExpressionList: []*wafv2.Regex{},
+		}
 
-	if v, ok := d.GetOk("regular_expression"); ok && v.(*schema.Set).Len() > 0 {
-		params.RegularExpressionList = expandRegexPatternSet(v.(*schema.Set).List())
-	}
+		if v, ok := d.GetOk("regular_expression"); ok && v.(*schema.Set).Len() > 0 {
+			params.RegularExpressionList = expandRegexPatternSet(v.(*schema.Set).List())
+		}
 
-	if v, ok := d.GetOk("description"); ok {
-		params.Description = aws.String(v.(string))
-	}
+		if v, ok := d.GetOk("description"); ok {
+			params.Description = aws.String(v.(string))
+		}
 
-	_, err := conn.UpdateRegexPatternSet(params)
+		_, err := conn.UpdateRegexPatternSet(params)
 
-	if err != nil {
-		return fmt.Errorf("Error updating WAFv2 RegexPatternSet: %s", err)
+		if err != nil {
+			return fmt.Errorf("Error updating WAFv2 RegexPatternSet: %s", err)
+		}
 	}
 
 	if d.HasChange("tags_all") {
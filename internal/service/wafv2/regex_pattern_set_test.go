@@ -164,7 +164,7 @@ func TestAccWAFV2RegexPatternSet_changeNameForceNew(t *testing.T) {
 }
 
 func TestAccWAFV2RegexPatternSet_tags(t *testing.T) {
-	var v wafv2.RegexPatternSet
+	var before, after wafv2.RegexPatternSet
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_wafv2_regex_pattern_set.test"
 
@@ -177,7 +177,7 @@ func TestAccWAFV2RegexPatternSet_tags(t *testing.T) {
 			{
 				Config: testAccRegexPatternSetConfig_OneTag(rName, "Tag1", "Value1"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRegexPatternSetExists(resourceName, &v),
+					testAccCheckRegexPatternSetExists(resourceName, &before),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "wafv2", regexp.MustCompile(`regional/regexpatternset/.+$`)),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.Tag1", "Value1"),
@@ -192,7 +192,8 @@ func TestAccWAFV2RegexPatternSet_tags(t *testing.T) {
 			{
 				Config: testAccRegexPatternSetConfig_TwoTags(rName, "Tag1", "Value1Updated", "Tag2", "Value2"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRegexPatternSetExists(resourceName, &v),
+					testAccCheckRegexPatternSetExists(resourceName, &after),
+					testAccCheckRegexPatternSetNotRecreated(&before, &after),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "wafv2", regexp.MustCompile(`regional/regexpatternset/.+$`)),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
 					resource.TestCheckResourceAttr(resourceName, "tags.Tag1", "Value1Updated"),
@@ -202,7 +203,8 @@ func TestAccWAFV2RegexPatternSet_tags(t *testing.T) {
 			{
 				Config: testAccRegexPatternSetConfig_OneTag(rName, "Tag2", "Value2"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRegexPatternSetExists(resourceName, &v),
+					testAccCheckRegexPatternSetExists(resourceName, &after),
+					testAccCheckRegexPatternSetNotRecreated(&before, &after),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "wafv2", regexp.MustCompile(`regional/regexpatternset/.+$`)),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.Tag2", "Value2"),
@@ -212,6 +214,16 @@ func TestAccWAFV2RegexPatternSet_tags(t *testing.T) {
 	})
 }
 
+func testAccCheckRegexPatternSetNotRecreated(before, after *wafv2.RegexPatternSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.Id), aws.StringValue(after.Id); before != after {
+			return fmt.Errorf("WAFv2 RegexPatternSet (%s) recreated (%s)", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckRegexPatternSetDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_wafv2_regex_pattern_set" {